@@ -36,6 +36,8 @@ func AdoptHz(gen *protogen.Plugin) error {
 	}
 	newGen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 
+	// the binding validator is generated once for a package with services
+	bindings := map[protogen.GoImportPath]bool{}
 	for _, f := range newGen.Files {
 		if strings.HasPrefix(f.Proto.GetPackage(), "google.protobuf") {
 			continue
@@ -53,6 +55,12 @@ func AdoptHz(gen *protogen.Plugin) error {
 		if err != nil {
 			return err
 		}
+		if len(f.Services) > 0 && !bindings[f.GoImportPath] {
+			bindings[f.GoImportPath] = true
+			if err = g.GenerateHertzBinding(); err != nil {
+				return err
+			}
+		}
 	}
 
 	*gen = *newGen
@@ -60,6 +60,9 @@ func AdoptKitex(gen *protogen.Plugin) error {
 		if err != nil {
 			return err
 		}
+		if err = g.GenerateKitexMiddleware(); err != nil {
+			return err
+		}
 	}
 
 	*gen = *newGen
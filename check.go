@@ -0,0 +1,119 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/checker"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// runCheck validates a payload against the rules in a descriptor set, it returns
+// the exit code: 0 for valid payload, 1 for violations of the error rules and 2 for
+// other errors.
+func runCheck(args []string) int {
+	var (
+		flags    = flag.NewFlagSet("check", flag.ExitOnError)
+		descPath = flags.String("descriptor_set", "", "FileDescriptorSet generated by 'protoc --include_imports --descriptor_set_out'")
+		msgName  = flags.String("message", "", "fully-qualified name of the message, e.g. 'psm.Request'")
+		format   = flags.String("format", "auto", "payload format: json, binary or auto")
+		severity = flags.String("severity", "error", "default severity of the rules: error or warn")
+	)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s check -descriptor_set <file> -message <name> [-format json|binary|auto] [payload file, '-' or empty for stdin]\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if *descPath == "" || *msgName == "" || flags.NArg() > 1 {
+		flags.Usage()
+		return 2
+	}
+
+	set, err := readDescriptorSet(*descPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	var payload []byte
+	if path := flags.Arg(0); path == "" || path == "-" {
+		payload, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		payload, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "read payload failed: %v\n", err)
+		return 2
+	}
+	var isJSON bool
+	switch *format {
+	case "json":
+		isJSON = true
+	case "binary":
+		isJSON = false
+	case "auto":
+		isJSON = strings.HasSuffix(flags.Arg(0), ".json") || bytes.HasPrefix(bytes.TrimSpace(payload), []byte("{"))
+	default:
+		fmt.Fprintf(os.Stderr, "unknown payload format: %s\n", *format)
+		return 2
+	}
+
+	if *severity != "error" && *severity != "warn" {
+		fmt.Fprintf(os.Stderr, "unknown severity: %s\n", *severity)
+		return 2
+	}
+	c, err := checker.NewChecker(set)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	c.SetSeverity(*severity)
+	m, err := c.Unmarshal(*msgName, payload, isJSON)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	violations, err := c.Check(m)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	code := 0
+	for _, v := range violations {
+		fmt.Fprintln(os.Stdout, v)
+		if !v.Warn {
+			code = 1
+		}
+	}
+	return code
+}
+
+func readDescriptorSet(path string) (*descriptorpb.FileDescriptorSet, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read descriptor set failed: %v", err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(data, set); err != nil {
+		return nil, fmt.Errorf("unmarshal descriptor set failed: %v", err)
+	}
+	return set, nil
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Violation is a rule failure found in a payload.
+type Violation struct {
+	Field  string // path of the field, e.g. "items[0].name"
+	Rule   string // rule key, e.g. "min_size"
+	Reason string
+	Warn   bool // the rule is of the warn severity, which does not fail the validation
+}
+
+func (v *Violation) String() string {
+	reason := v.Reason
+	if v.Warn {
+		reason = "[warn] " + reason
+	}
+	if v.Field == "" {
+		return reason
+	}
+	return v.Field + ": " + reason
+}
+
+// Checker evaluates the vt rules of the messages in a FileDescriptorSet against
+// payloads without generated code.
+type Checker struct {
+	plugin   *protogen.Plugin
+	parser   *parser.Parser
+	messages map[protoreflect.FullName]*protogen.Message
+	parsed   map[*protogen.Message]*parsedMessage
+	severity string
+}
+
+type parsedMessage struct {
+	msgValidation    *parser.Validation
+	fieldValidations map[protoreflect.FieldNumber]*parser.Validation
+}
+
+// NewChecker builds a Checker from a FileDescriptorSet, which must contain all the
+// imported files (protoc --include_imports).
+func NewChecker(set *descriptorpb.FileDescriptorSet) (*Checker, error) {
+	// protogen requires a go import path for every file, the real one is not needed
+	// for evaluation, so fill in a placeholder for files without go_package.
+	var params []string
+	for _, f := range set.GetFile() {
+		if f.GetOptions().GetGoPackage() == "" {
+			params = append(params, fmt.Sprintf("M%s=checker/%s", f.GetName(), strings.TrimSuffix(f.GetName(), ".proto")))
+		}
+	}
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		ProtoFile: set.GetFile(),
+		Parameter: proto.String(strings.Join(params, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load descriptor set failed: %w", err)
+	}
+	c := &Checker{
+		plugin:   gen,
+		parser:   parser.NewParser(),
+		messages: make(map[protoreflect.FullName]*protogen.Message),
+		parsed:   make(map[*protogen.Message]*parsedMessage),
+	}
+	for _, f := range gen.Files {
+		c.addMessages(f.Messages)
+	}
+	return c, nil
+}
+
+func (c *Checker) addMessages(msgs []*protogen.Message) {
+	for _, msg := range msgs {
+		c.messages[msg.Desc.FullName()] = msg
+		c.addMessages(msg.Messages)
+	}
+}
+
+// SetSeverity sets the severity of the rules without one, error or warn, like the
+// severity parameter of the plugin.
+func (c *Checker) SetSeverity(severity string) {
+	c.severity = severity
+}
+
+// Unmarshal decodes a JSON or binary payload as the message with the given full name.
+func (c *Checker) Unmarshal(name string, payload []byte, isJSON bool) (protoreflect.Message, error) {
+	msg, ok := c.messages[protoreflect.FullName(name)]
+	if !ok {
+		return nil, fmt.Errorf("message %s not found in descriptor set", name)
+	}
+	m := dynamicpb.NewMessage(msg.Desc)
+	var err error
+	if isJSON {
+		err = protojson.Unmarshal(payload, m)
+	} else {
+		err = proto.Unmarshal(payload, m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode payload as %s failed: %w", name, err)
+	}
+	return m, nil
+}
+
+// Check evaluates the rules of m and its nested messages, and returns all the violations.
+func (c *Checker) Check(m protoreflect.Message) ([]*Violation, error) {
+	msg, ok := c.messages[m.Descriptor().FullName()]
+	if !ok {
+		return nil, fmt.Errorf("message %s not found in descriptor set", m.Descriptor().FullName())
+	}
+	e := &evaluator{checker: c}
+	if err := e.checkMessage("", msg, m); err != nil {
+		return nil, err
+	}
+	return e.violations, nil
+}
+
+func (c *Checker) parse(msg *protogen.Message) (*parsedMessage, error) {
+	if pm, ok := c.parsed[msg]; ok {
+		return pm, nil
+	}
+	msgValidation, fieldValidations, err := c.parser.Parse(msg)
+	if err != nil {
+		return nil, err
+	}
+	pm := &parsedMessage{msgValidation: msgValidation, fieldValidations: fieldValidations}
+	c.parsed[msg] = pm
+	return pm, nil
+}
@@ -0,0 +1,290 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const validRequest = `{
+	"page": 3,
+	"ratio": 0.5,
+	"enabled": true,
+	"code": "CN-001",
+	"token": "dG9rZW4=",
+	"status": "STATUS_ACTIVE",
+	"tags": ["a", "b"],
+	"items": [{"name": "pen", "count": 1}],
+	"quotas": {"cpu": "1"},
+	"slots": {"1": {"name": "slot", "count": 2}},
+	"main": {"name": "main", "count": 100},
+	"extra": {},
+	"max": "3",
+	"deadline": "9223372036854775807",
+	"coupon": "SAVE10"
+}`
+
+func newChecker(t *testing.T) *Checker {
+	b, err := ioutil.ReadFile("../testdata/vt.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(b, set); err != nil {
+		t.Fatal(err)
+	}
+	c, err := NewChecker(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+// request returns the valid request with the fields replaced by patch.
+func request(t *testing.T, patch map[string]interface{}) []byte {
+	var m map[string]interface{}
+	if err := json.Unmarshal([]byte(validRequest), &m); err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range patch {
+		if v == nil {
+			delete(m, k)
+			continue
+		}
+		m[k] = v
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestCheck(t *testing.T) {
+	c := newChecker(t)
+	tests := []struct {
+		name  string
+		patch map[string]interface{}
+		want  []string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:  "numeric",
+			patch: map[string]interface{}{"page": 1001, "max": "1001"},
+			want:  []string{"page: lt rule failed, current value: 1001"},
+		},
+		{
+			name:  "optional",
+			patch: map[string]interface{}{"ratio": nil},
+			want:  []string{"ratio: not_nil rule failed, current value: nil", "ratio: gt rule failed, current value: 0"},
+		},
+		{
+			name:  "string",
+			patch: map[string]interface{}{"code": "CN-000", "token": "dG9r"},
+			want:  []string{"code: not_in rule failed, current value: \"CN-000\"", "token: min_size rule failed, current length: 3"},
+		},
+		{
+			name:  "enum",
+			patch: map[string]interface{}{"status": 3},
+			want:  []string{"status: defined_only rule failed, current value: 3"},
+		},
+		{
+			name:  "list",
+			patch: map[string]interface{}{"tags": []string{"a", "d", "b", "c"}},
+			want:  []string{"tags: max_size rule failed, current size: 4", "tags[1]: tag d is not allowed"},
+		},
+		{
+			name:  "nested",
+			patch: map[string]interface{}{"items": []interface{}{map[string]interface{}{"name": "Pen", "count": 1}}},
+			want:  []string{"items[0].name: pattern rule failed, current value: \"Pen\""},
+		},
+		{
+			name:  "map",
+			patch: map[string]interface{}{"quotas": map[string]interface{}{"": "-1"}},
+			want:  []string{"quotas[\"\"]: min_size rule failed, current length: 0", "quotas[\"\"]: ge rule failed, current value: -1"},
+		},
+		{
+			name:  "not nil",
+			patch: map[string]interface{}{"main": nil, "extra": map[string]interface{}{"count": -1}},
+			want: []string{
+				"main: not_nil rule failed, current value: nil",
+				"main.name: min_size rule failed, current length: 0",
+				"main.name: pattern rule failed, current value: \"\"",
+				"main.count: main.count must be greater than 0, got 0",
+			},
+		},
+		{
+			name:  "reference and function",
+			patch: map[string]interface{}{"page": 5, "max": "3", "deadline": "1"},
+			want:  []string{"max: ge rule failed, current value: 3", "deadline: gt rule failed, current value: 1"},
+		},
+		{
+			name:  "assert",
+			patch: map[string]interface{}{"page": 4, "max": "4"},
+			want:  []string{"struct assertion failed"},
+		},
+		{
+			name:  "when",
+			patch: map[string]interface{}{"coupon": "ab"},
+			want:  []string{"coupon: min_size rule failed, current length: 2"},
+		},
+		{
+			name:  "when not met",
+			patch: map[string]interface{}{"coupon": "ab", "status": "STATUS_DELETED"},
+		},
+		{
+			name:  "warn",
+			patch: map[string]interface{}{"labels": []string{"a", "b", ""}},
+			want:  []string{"labels: [warn] max_size rule failed, current size: 3", "labels[2]: min_size rule failed, current length: 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := c.Unmarshal("fixture.Request", request(t, tt.patch), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			vs, err := c.Check(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range vs {
+				got = append(got, v.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	c := newChecker(t)
+	if _, err := c.Unmarshal("fixture.Unknown", []byte("{}"), true); err == nil {
+		t.Error("Unmarshal() of an unknown message should fail")
+	}
+	m, err := c.Unmarshal("fixture.Request", request(t, nil), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := proto.Marshal(m.Interface())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err = c.Unmarshal("fixture.Request", b, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vs, err := c.Check(m); err != nil || len(vs) != 0 {
+		t.Errorf("Check() of the binary payload = %v, %v, want no violations", vs, err)
+	}
+}
+
+func TestSetSeverity(t *testing.T) {
+	c := newChecker(t)
+	c.SetSeverity("warn")
+	m, err := c.Unmarshal("fixture.Request", request(t, map[string]interface{}{"page": 1001, "max": "1001", "labels": []string{"a", ""}}), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vs, err := c.Check(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, v := range vs {
+		got = append(got, v.String())
+	}
+	// the rules with a severity keep it
+	want := []string{"page: [warn] lt rule failed, current value: 1001", "labels[1]: min_size rule failed, current length: 0"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %q, want %q", got, want)
+	}
+}
+
+func TestCheckMeasure(t *testing.T) {
+	c := newChecker(t)
+	tests := []struct {
+		name    string
+		payload string
+		want    []string
+	}{
+		{
+			name:    "valid",
+			payload: `{"value": -100, "ratio": 0.3005, "score": 9.5, "step": -15, "weights": [0.5, 1]}`,
+		},
+		{
+			name:    "allowed nan",
+			payload: `{"ratio": 0.3, "score": "NaN"}`,
+		},
+		{
+			name:    "nan",
+			payload: `{"value": "NaN", "ratio": "NaN"}`,
+			want: []string{
+				"value: finite rule failed, current value: NaN",
+				"value: ge rule failed, current value: NaN",
+				"value: le rule failed, current value: NaN",
+				"ratio: const rule failed, current value: NaN",
+			},
+		},
+		{
+			name:    "infinity",
+			payload: `{"value": "-Infinity", "ratio": 0.3, "weights": ["Infinity"]}`,
+			want: []string{
+				"value: finite rule failed, current value: -Inf",
+				"value: ge rule failed, current value: -Inf",
+				"weights[0]: finite rule failed, current value: +Inf",
+			},
+		},
+		{
+			name:    "epsilon",
+			payload: `{"ratio": 0.302}`,
+			want:    []string{"ratio: const rule failed, current value: 0.302"},
+		},
+		{
+			name:    "multiple of",
+			payload: `{"ratio": 0.3, "step": 12}`,
+			want:    []string{"step: multiple_of rule failed, current value: 12"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := c.Unmarshal("fixture.Measure", []byte(tt.payload), true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			vs, err := c.Check(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range vs {
+				got = append(got, v.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,585 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// evaluator walks a message the same way the generated Validate() does, but
+// collects every violation instead of returning on the first one.
+type evaluator struct {
+	checker    *Checker
+	violations []*Violation
+}
+
+func (e *evaluator) fail(path string, rule *parser.Rule, format string, a ...interface{}) {
+	reason := fmt.Sprintf("%s rule failed, ", parser.KeyString[rule.Key]) + fmt.Sprintf(format, a...)
+	if rule.Message != "" {
+		var value interface{}
+		if len(a) > 0 {
+			value = a[0]
+		}
+		reason = ruleMessage(rule, path, value)
+	}
+	e.violations = append(e.violations, &Violation{
+		Field:  path,
+		Rule:   parser.KeyString[rule.Key],
+		Reason: reason,
+		Warn:   rule.IsWarn(e.checker.severity),
+	})
+}
+
+// ruleMessage fills the message template of a rule, the field is the path of the
+// field in the payload, or the message name for the message level rules.
+func ruleMessage(rule *parser.Rule, field string, value interface{}) string {
+	v := ""
+	if value != nil {
+		v = fmt.Sprint(value)
+	}
+	return strings.NewReplacer("{field}", field, "{value}", v, "{constraint}", rule.Constraint()).Replace(rule.Message)
+}
+
+// skip reports a rule that can not be evaluated without generated code, such as
+// customized functions.
+func (e *evaluator) skip(path string, key parser.Key, err error) {
+	log.Printf("%s: %s rule skipped: %v", path, parser.KeyString[key], err)
+}
+
+func (e *evaluator) checkMessage(path string, msg *protogen.Message, m protoreflect.Message) error {
+	pm, err := e.checker.parse(msg)
+	if err != nil {
+		return err
+	}
+	for _, f := range msg.Fields {
+		v := pm.fieldValidations[f.Desc.Number()]
+		if v == nil || len(v.Rules) == 0 {
+			continue
+		}
+		fieldPath := joinPath(path, string(f.Desc.Name()))
+		if v.When != nil {
+			ret, err := e.function(m, v.When)
+			if err != nil {
+				log.Printf("%s: rules skipped, when can not be evaluated: %v", fieldPath, err)
+				continue
+			}
+			if ok, _ := ret.(bool); !ok {
+				continue
+			}
+		}
+		if err := e.checkField(fieldPath, m, f.Desc, m.Get(f.Desc), v, false); err != nil {
+			return err
+		}
+	}
+	for _, rule := range pm.msgValidation.Rules {
+		switch rule.Key {
+		case parser.Assert:
+			ret, err := e.function(m, rule.Specified.TypedValue.Function)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if ok, _ := ret.(bool); !ok {
+				reason := "struct assertion failed"
+				if rule.Message != "" {
+					reason = ruleMessage(rule, string(msg.Desc.Name()), nil)
+				}
+				e.violations = append(e.violations, &Violation{
+					Field:  path,
+					Rule:   parser.KeyString[rule.Key],
+					Reason: reason,
+					Warn:   rule.IsWarn(e.checker.severity),
+				})
+			}
+		case parser.OneofRequired:
+			oneof := msg.Desc.Oneofs().ByName(protoreflect.Name(rule.Specified.TypedValue.Binary))
+			if oneof == nil {
+				return fmt.Errorf("oneof %s not found", rule.Specified.TypedValue.Binary)
+			}
+			if m.WhichOneof(oneof) == nil {
+				reason := "one of the fields must be set"
+				if rule.Message != "" {
+					reason = ruleMessage(rule, string(msg.Desc.Name()), nil)
+				}
+				e.violations = append(e.violations, &Violation{
+					Field:  joinPath(path, string(oneof.Name())),
+					Rule:   parser.KeyString[rule.Key],
+					Reason: reason,
+					Warn:   rule.IsWarn(e.checker.severity),
+				})
+			}
+		default:
+			return fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkField(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, val protoreflect.Value, v *parser.Validation, isInnerType bool) error {
+	if !isInnerType {
+		for _, rule := range v.Rules {
+			if rule.Key == parser.NotNil && rule.Specified.TypedValue.Bool && canBeNil(fd) && !owner.Has(fd) {
+				e.fail(path, rule, "current value: nil")
+			}
+		}
+		if fd.IsList() {
+			return e.checkList(path, owner, fd, val.List(), v)
+		}
+		if fd.IsMap() {
+			return e.checkMap(path, owner, fd, val.Map(), v)
+		}
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return e.checkStructLikeField(path, fd, val, v)
+	case protoreflect.EnumKind:
+		return e.checkEnum(path, owner, fd, val, v)
+	case protoreflect.BoolKind:
+		return e.checkBool(path, owner, val, v)
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return e.checkBinary(path, owner, fd, val, v)
+	default:
+		return e.checkNumeric(path, owner, fd, val, v)
+	}
+}
+
+func (e *evaluator) checkNumeric(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, val protoreflect.Value, v *parser.Validation) error {
+	target := scalar(fd, val)
+	f, isFloat := target.(float64)
+	isNaN := isFloat && math.IsNaN(f)
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.Const, parser.LessThan, parser.LessEqual, parser.GreatThan, parser.GreatEqual:
+			source, err := e.value(owner, rule.Specified)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if isNaN {
+				// NaN never matches a const, and only passes the ranges if allowed
+				if rule.Key == parser.Const || !v.AllowNaN {
+					e.fail(path, rule, "current value: %v", target)
+				}
+				continue
+			}
+			c, err := compare(target, source)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			var failed bool
+			switch rule.Key {
+			case parser.Const:
+				failed = c != 0
+				if isFloat && v.Epsilon > 0 {
+					s, _ := toFloat(source)
+					failed = !(math.Abs(f-s) <= v.Epsilon)
+				}
+			case parser.LessThan:
+				failed = c == 0 || c == 1
+			case parser.LessEqual:
+				failed = c == 1
+			case parser.GreatThan:
+				failed = c == 0 || c == -1
+			case parser.GreatEqual:
+				failed = c == -1
+			}
+			if failed {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.In, parser.NotIn:
+			exist, err := e.in(owner, target, rule.Range)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if exist != (rule.Key == parser.In) {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.MultipleOf:
+			source, err := e.value(owner, rule.Specified)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			var failed bool
+			switch x := target.(type) {
+			case int64:
+				failed = x%toInt(source) != 0
+			case uint64:
+				failed = x%uint64(toInt(source)) != 0
+			}
+			if failed {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.Finite:
+			if rule.Specified.TypedValue.Bool && isFloat && (math.IsNaN(f) || math.IsInf(f, 0)) {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.NotNil:
+			// checked in checkField
+		default:
+			return fmt.Errorf("unknown numeric annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkBool(path string, owner protoreflect.Message, val protoreflect.Value, v *parser.Validation) error {
+	target := val.Bool()
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.Const:
+			source, err := e.value(owner, rule.Specified)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if source != target {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.NotNil:
+			// checked in checkField
+		default:
+			return fmt.Errorf("unknown bool annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkBinary(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, val protoreflect.Value, v *parser.Validation) error {
+	target := scalar(fd, val).(string)
+	for _, rule := range v.Rules {
+		var source interface{}
+		switch rule.Key {
+		case parser.In, parser.NotIn:
+			exist, err := e.in(owner, target, rule.Range)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if exist != (rule.Key == parser.In) {
+				e.fail(path, rule, "current value: %q", target)
+			}
+			continue
+		case parser.NotNil:
+			continue
+		default:
+			var err error
+			source, err = e.value(owner, rule.Specified)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+		}
+
+		switch rule.Key {
+		case parser.MinSize, parser.MaxSize:
+			c, err := compare(int64(len(target)), source)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			if (rule.Key == parser.MinSize && c < 0) || (rule.Key == parser.MaxSize && c > 0) {
+				e.fail(path, rule, "current length: %d", len(target))
+			}
+			continue
+		}
+
+		str, ok := source.(string)
+		if !ok {
+			e.skip(path, rule.Key, fmt.Errorf("value %v is not a string", source))
+			continue
+		}
+		var failed bool
+		switch rule.Key {
+		case parser.Const:
+			failed = target != str
+		case parser.Prefix:
+			failed = !strings.HasPrefix(target, str)
+		case parser.Suffix:
+			failed = !strings.HasSuffix(target, str)
+		case parser.Contains:
+			failed = !strings.Contains(target, str)
+		case parser.NotContains:
+			failed = strings.Contains(target, str)
+		case parser.Pattern:
+			re, err := regexp.Compile(str)
+			if err != nil {
+				e.skip(path, rule.Key, err)
+				continue
+			}
+			failed = !re.MatchString(target)
+		default:
+			return fmt.Errorf("unknown binary annotation %s", parser.KeyString[rule.Key])
+		}
+		if failed {
+			e.fail(path, rule, "current value: %q", target)
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkEnum(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, val protoreflect.Value, v *parser.Validation) error {
+	target := val.Enum()
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.Const:
+			identifier := rule.Specified.TypedValue.Binary
+			divId := strings.Split(identifier, ".")
+			if len(divId) < 2 {
+				return fmt.Errorf("wrong format for enum rule: %s", identifier)
+			}
+			ev := fd.Enum().Values().ByName(protoreflect.Name(divId[len(divId)-1]))
+			if ev == nil {
+				return fmt.Errorf("can not find enum value '%s' in %s", identifier, fd.Enum().FullName())
+			}
+			if target != ev.Number() {
+				e.fail(path, rule, "current value: %v", enumName(fd, target))
+			}
+		case parser.DefinedOnly:
+			if rule.Specified.TypedValue.Bool && fd.Enum().Values().ByNumber(target) == nil {
+				e.fail(path, rule, "current value: %v", target)
+			}
+		case parser.In, parser.NotIn:
+			found := false
+			for _, val := range rule.Range {
+				divId := strings.Split(val.TypedValue.Binary, ".")
+				ev := fd.Enum().Values().ByName(protoreflect.Name(divId[len(divId)-1]))
+				if ev == nil {
+					return fmt.Errorf("can not find enum value '%s' in %s", val.TypedValue.Binary, fd.Enum().FullName())
+				}
+				found = found || target == ev.Number()
+			}
+			if found != (rule.Key == parser.In) {
+				e.fail(path, rule, "current value: %v", enumName(fd, target))
+			}
+		case parser.NotNil:
+			// checked in checkField
+		default:
+			return fmt.Errorf("unknown enum annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkStructLikeField(path string, fd protoreflect.FieldDescriptor, val protoreflect.Value, v *parser.Validation) error {
+	var notNil bool
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.Skip:
+			if rule.Specified.TypedValue.Bool {
+				return nil
+			}
+		case parser.NotNil:
+			// checked in checkField, the nested rules are still checked to report
+			// all the violations
+			notNil = rule.Specified.TypedValue.Bool
+		default:
+			return fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	// an unset message is valid unless not_nil is set
+	if !notNil && !val.Message().IsValid() {
+		return nil
+	}
+	msg, ok := e.checker.messages[fd.Message().FullName()]
+	if !ok {
+		return fmt.Errorf("message %s not found in descriptor set", fd.Message().FullName())
+	}
+	return e.checkMessage(path, msg, val.Message())
+}
+
+func (e *evaluator) checkList(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, list protoreflect.List, v *parser.Validation) error {
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.MinSize, parser.MaxSize:
+			if e.checkSize(path, owner, rule, list.Len()) {
+				e.fail(path, rule, "current size: %d", list.Len())
+			}
+		case parser.Elem:
+			for i := 0; i < list.Len(); i++ {
+				if err := e.checkField(fmt.Sprintf("%s[%d]", path, i), owner, fd, list.Get(i), rule.Inner, true); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unknown list annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+func (e *evaluator) checkMap(path string, owner protoreflect.Message, fd protoreflect.FieldDescriptor, m protoreflect.Map, v *parser.Validation) error {
+	var keys []protoreflect.MapKey
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	elemPath := func(k protoreflect.MapKey) string {
+		if fd.MapKey().Kind() == protoreflect.StringKind {
+			return fmt.Sprintf("%s[%q]", path, k.String())
+		}
+		return fmt.Sprintf("%s[%s]", path, k.String())
+	}
+
+	for _, rule := range v.Rules {
+		switch rule.Key {
+		case parser.MinSize, parser.MaxSize:
+			if e.checkSize(path, owner, rule, m.Len()) {
+				e.fail(path, rule, "current size: %d", m.Len())
+			}
+		case parser.NoSparse:
+			if fd.MapValue().Kind() != protoreflect.MessageKind {
+				return fmt.Errorf("field %s: no_sparse rule is only applicable for embedded message types", path)
+			}
+			if !rule.Specified.TypedValue.Bool {
+				continue
+			}
+			for _, k := range keys {
+				if !m.Get(k).Message().IsValid() {
+					e.fail(elemPath(k), rule, "current value: nil")
+				}
+			}
+		case parser.MapKey:
+			for _, k := range keys {
+				if err := e.checkField(elemPath(k), owner, fd.MapKey(), k.Value(), rule.Inner, true); err != nil {
+					return err
+				}
+			}
+		case parser.MapValue:
+			for _, k := range keys {
+				if err := e.checkField(elemPath(k), owner, fd.MapValue(), m.Get(k), rule.Inner, true); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("unknown map annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return nil
+}
+
+// checkSize reports whether size breaks a min_size/max_size rule.
+func (e *evaluator) checkSize(path string, owner protoreflect.Message, rule *parser.Rule, size int) bool {
+	source, err := e.value(owner, rule.Specified)
+	if err != nil {
+		e.skip(path, rule.Key, err)
+		return false
+	}
+	c, err := compare(int64(size), source)
+	if err != nil {
+		e.skip(path, rule.Key, err)
+		return false
+	}
+	if rule.Key == parser.MinSize {
+		return c < 0
+	}
+	return c > 0
+}
+
+func (e *evaluator) in(owner protoreflect.Message, target interface{}, vals []*parser.ValidationValue) (bool, error) {
+	for _, val := range vals {
+		source, err := e.value(owner, val)
+		if err != nil {
+			return false, err
+		}
+		// the values of in/not_in are parsed as the type of the field,
+		// except for the field references
+		if eq, _ := equal(target, source); eq {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// value resolves a ValidationValue in the scope of owner.
+func (e *evaluator) value(owner protoreflect.Message, val *parser.ValidationValue) (interface{}, error) {
+	switch val.ValueType {
+	case parser.IntValue, parser.EnumValue:
+		return val.TypedValue.Int, nil
+	case parser.DoubleValue:
+		return val.TypedValue.Double, nil
+	case parser.BoolValue:
+		return val.TypedValue.Bool, nil
+	case parser.BinaryValue:
+		return val.TypedValue.Binary, nil
+	case parser.FieldReferenceValue:
+		fd := val.TypedValue.FieldReference.Desc
+		if fd.IsList() || fd.IsMap() || fd.Kind() == protoreflect.MessageKind {
+			return nil, fmt.Errorf("field reference %s is not a scalar", fd.Name())
+		}
+		return scalar(fd, owner.Get(fd)), nil
+	case parser.FunctionValue:
+		return e.function(owner, val.TypedValue.Function)
+	default:
+		return nil, fmt.Errorf("unsupported value type %s", val.ValueType)
+	}
+}
+
+func canBeNil(fd protoreflect.FieldDescriptor) bool {
+	return fd.HasPresence() || fd.IsList() || fd.IsMap()
+}
+
+// scalar converts a protoreflect.Value to int64, uint64, float64, bool or string.
+func scalar(fd protoreflect.FieldDescriptor, val protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return val.Bool()
+	case protoreflect.EnumKind:
+		return int64(val.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return val.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return val.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return val.Float()
+	case protoreflect.StringKind:
+		return val.String()
+	case protoreflect.BytesKind:
+		return string(val.Bytes())
+	default:
+		return val.Interface()
+	}
+}
+
+func enumName(fd protoreflect.FieldDescriptor, n protoreflect.EnumNumber) string {
+	if ev := fd.Enum().Values().ByNumber(n); ev != nil {
+		return string(ev.Name())
+	}
+	return fmt.Sprint(n)
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
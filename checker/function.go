@@ -0,0 +1,314 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// unordered is returned by compare when one of the operands is NaN.
+const unordered = 2
+
+// function evaluates the built-in functions, customized functions are go templates
+// and can not be evaluated here.
+func (e *evaluator) function(owner protoreflect.Message, f *parser.ToolFunction) (interface{}, error) {
+	switch f.Name {
+	case "len":
+		if len(f.Arguments) != 1 || f.Arguments[0].ValueType != parser.FieldReferenceValue {
+			return nil, errors.New("function len needs a field reference argument")
+		}
+		fd := f.Arguments[0].TypedValue.FieldReference.Desc
+		v := owner.Get(fd)
+		switch {
+		case fd.IsList():
+			return int64(v.List().Len()), nil
+		case fd.IsMap():
+			return int64(v.Map().Len()), nil
+		case fd.Kind() == protoreflect.StringKind:
+			return int64(len(v.String())), nil
+		case fd.Kind() == protoreflect.BytesKind:
+			return int64(len(v.Bytes())), nil
+		default:
+			return nil, fmt.Errorf("function len is not applicable for field %s", fd.Name())
+		}
+	case "sprintf":
+		var args []interface{}
+		for i := range f.Arguments {
+			arg, err := e.value(owner, &f.Arguments[i])
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		if len(args) == 0 {
+			return nil, errors.New("function sprintf needs a format argument")
+		}
+		format, ok := args[0].(string)
+		if !ok {
+			return nil, errors.New("the format of sprintf must be a string")
+		}
+		// literals are emitted as go string literals by the generator
+		if unquoted, err := strconv.Unquote("\"" + format + "\""); err == nil {
+			format = unquoted
+		}
+		return fmt.Sprintf(format, args[1:]...), nil
+	case "size", "has":
+		if len(f.Arguments) != 1 || f.Arguments[0].ValueType != parser.FieldReferenceValue {
+			return nil, fmt.Errorf("function %s needs a field reference argument", f.Name)
+		}
+		fd := f.Arguments[0].TypedValue.FieldReference.Desc
+		if f.Name == "has" {
+			return owner.Has(fd), nil
+		}
+		v := owner.Get(fd)
+		switch {
+		case fd.IsList():
+			return int64(v.List().Len()), nil
+		case fd.IsMap():
+			return int64(v.Map().Len()), nil
+		case fd.Kind() == protoreflect.StringKind:
+			return int64(utf8.RuneCountInString(v.String())), nil
+		case fd.Kind() == protoreflect.BytesKind:
+			return int64(len(v.Bytes())), nil
+		default:
+			return nil, fmt.Errorf("function size is not applicable for field %s", fd.Name())
+		}
+	case "and", "or", "not":
+		if len(f.Arguments) == 0 {
+			return nil, fmt.Errorf("function %s needs at least 1 argument", f.Name)
+		}
+		for i := range f.Arguments {
+			arg, err := e.value(owner, &f.Arguments[i])
+			if err != nil {
+				return nil, err
+			}
+			b, ok := arg.(bool)
+			if !ok {
+				return nil, fmt.Errorf("the arguments of %s must be bool, got %v(%T)", f.Name, arg, arg)
+			}
+			switch {
+			case f.Name == "not":
+				return !b, nil
+			case f.Name == "and" && !b:
+				return false, nil
+			case f.Name == "or" && b:
+				return true, nil
+			}
+		}
+		return f.Name == "and", nil
+	case "ne", "lt", "le", "gt", "ge":
+		if len(f.Arguments) < 2 {
+			return nil, fmt.Errorf("binary function %s needs at least 2 arguments", f.Name)
+		}
+		a, err := e.value(owner, &f.Arguments[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := e.value(owner, &f.Arguments[1])
+		if err != nil {
+			return nil, err
+		}
+		if f.Name == "ne" {
+			eq, err := equal(a, b)
+			return !eq, err
+		}
+		c, err := compare(a, b)
+		if err != nil {
+			return nil, err
+		}
+		switch f.Name {
+		case "lt":
+			return c == -1, nil
+		case "le":
+			return c == -1 || c == 0, nil
+		case "gt":
+			return c == 1, nil
+		default:
+			return c == 1 || c == 0, nil
+		}
+	case "equal", "mod", "add":
+		if len(f.Arguments) < 2 {
+			return nil, fmt.Errorf("binary function %s needs at least 2 arguments", f.Name)
+		}
+		a, err := e.value(owner, &f.Arguments[0])
+		if err != nil {
+			return nil, err
+		}
+		b, err := e.value(owner, &f.Arguments[1])
+		if err != nil {
+			return nil, err
+		}
+		if f.Name == "equal" {
+			return equal(a, b)
+		}
+		return arith(f.Name, a, b)
+	case "now_unix_nano":
+		return time.Now().UnixNano(), nil
+	default:
+		return nil, fmt.Errorf("customized function %s can not be evaluated", f.Name)
+	}
+}
+
+// compare returns -1, 0, 1 or unordered.
+func compare(a, b interface{}) (int, error) {
+	switch x := a.(type) {
+	case int64:
+		switch y := b.(type) {
+		case int64:
+			return cmpInt(x, y), nil
+		case uint64:
+			if x < 0 {
+				return -1, nil
+			}
+			return cmpUint(uint64(x), y), nil
+		case float64:
+			return cmpFloat(float64(x), y), nil
+		}
+	case uint64:
+		switch y := b.(type) {
+		case int64:
+			if y < 0 {
+				return 1, nil
+			}
+			return cmpUint(x, uint64(y)), nil
+		case uint64:
+			return cmpUint(x, y), nil
+		case float64:
+			return cmpFloat(float64(x), y), nil
+		}
+	case float64:
+		switch y := b.(type) {
+		case int64:
+			return cmpFloat(x, float64(y)), nil
+		case uint64:
+			return cmpFloat(x, float64(y)), nil
+		case float64:
+			return cmpFloat(x, y), nil
+		}
+	case string:
+		if y, ok := b.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+	return 0, fmt.Errorf("can not compare %v(%T) with %v(%T)", a, a, b, b)
+}
+
+func equal(a, b interface{}) (bool, error) {
+	if x, ok := a.(bool); ok {
+		y, ok := b.(bool)
+		if !ok {
+			return false, fmt.Errorf("can not compare %v(%T) with %v(%T)", a, a, b, b)
+		}
+		return x == y, nil
+	}
+	c, err := compare(a, b)
+	if err != nil {
+		return false, err
+	}
+	return c == 0, nil
+}
+
+func arith(op string, a, b interface{}) (interface{}, error) {
+	if x, ok := a.(string); ok {
+		y, ok := b.(string)
+		if !ok || op != "add" {
+			return nil, fmt.Errorf("function %s is not applicable for %v and %v", op, a, b)
+		}
+		return x + y, nil
+	}
+	x, xok := toFloat(a)
+	y, yok := toFloat(b)
+	if !xok || !yok {
+		return nil, fmt.Errorf("function %s is not applicable for %v and %v", op, a, b)
+	}
+	_, xf := a.(float64)
+	_, yf := b.(float64)
+	if xf || yf {
+		if op == "mod" {
+			return nil, errors.New("function mod needs integer arguments")
+		}
+		return x + y, nil
+	}
+	i, j := toInt(a), toInt(b)
+	if op == "mod" {
+		if j == 0 {
+			return nil, errors.New("integer divide by zero")
+		}
+		return i % j, nil
+	}
+	return i + j, nil
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case uint64:
+		return float64(x), true
+	case float64:
+		return x, true
+	}
+	return 0, false
+}
+
+func toInt(v interface{}) int64 {
+	switch x := v.(type) {
+	case int64:
+		return x
+	case uint64:
+		return int64(x)
+	}
+	return 0
+}
+
+func cmpInt(x, y int64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func cmpUint(x, y uint64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+func cmpFloat(x, y float64) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	case x == y:
+		return 0
+	}
+	return unordered
+}
@@ -17,13 +17,16 @@ package config
 import (
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
 // Config .
 type Config struct {
-	funcs map[string]*template.Template
+	funcs    map[string]*template.Template
+	severity string
+	hooks    bool
 }
 
 // Unpack restores the Config from a slice of "key=val" strings.
@@ -56,6 +59,17 @@ func (c *Config) Unpack(args []string) error {
 				return fmt.Errorf("parse customized function %s's template failed: %v", funcName, err)
 			}
 			c.funcs[funcName] = t
+		case "severity":
+			if value != "error" && value != "warn" {
+				return fmt.Errorf("unknown severity: '%s'", value)
+			}
+			c.severity = value
+		case "hooks":
+			hooks, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid hooks: '%s'", value)
+			}
+			c.hooks = hooks
 		}
 	}
 	return nil
@@ -64,3 +78,13 @@ func (c *Config) Unpack(args []string) error {
 func (c *Config) GetFunction(name string) *template.Template {
 	return c.funcs[name]
 }
+
+// GetHooks reports whether the generated code calls the hooks of vt on the failed rules.
+func (c *Config) GetHooks() bool {
+	return c.hooks
+}
+
+// GetSeverity returns the default severity of the rules, which is empty for error.
+func (c *Config) GetSeverity() string {
+	return c.severity
+}
@@ -0,0 +1,188 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doc
+
+import (
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// describe renders the rules of v as human-readable constraints, one per line.
+// f is the field, or the key or value field of a map, the rules are applied to.
+// The rules of the warn severity are marked, severity is the default of them.
+func describe(f *protogen.Field, v *parser.Validation, severity string) [][]span {
+	rules := make(map[parser.Key]*parser.Rule, len(v.Rules))
+	for _, rule := range v.Rules {
+		if _, ok := rules[rule.Key]; !ok {
+			rules[rule.Key] = rule
+		}
+	}
+	var ret [][]span
+	for _, rule := range v.Rules {
+		if rules[rule.Key] != rule {
+			// only the first rule of a key is in effect
+			continue
+		}
+		var line []span
+		switch rule.Key {
+		case parser.Const:
+			line = []span{text("must be "), value(rule.Specified)}
+			if v.Epsilon > 0 {
+				line = append(line, text(" within "), code(strconv.FormatFloat(v.Epsilon, 'g', -1, 64)))
+			}
+		case parser.GreatEqual, parser.LessEqual:
+			lower, upper := rules[parser.GreatEqual], rules[parser.LessEqual]
+			if lower != nil && upper != nil {
+				if rule == lower {
+					line = []span{text("between "), value(lower.Specified), text(" and "), value(upper.Specified)}
+				}
+				break
+			}
+			if rule.Key == parser.GreatEqual {
+				line = []span{text("at least "), value(rule.Specified)}
+			} else {
+				line = []span{text("at most "), value(rule.Specified)}
+			}
+		case parser.GreatThan, parser.LessThan:
+			lower, upper := rules[parser.GreatThan], rules[parser.LessThan]
+			if lower != nil && upper != nil {
+				if rule == lower {
+					line = []span{text("between "), value(lower.Specified), text(" and "), value(upper.Specified), text(" (exclusive)")}
+				}
+				break
+			}
+			if rule.Key == parser.GreatThan {
+				line = []span{text("greater than "), value(rule.Specified)}
+			} else {
+				line = []span{text("less than "), value(rule.Specified)}
+			}
+		case parser.MinSize, parser.MaxSize:
+			noun := sizeNoun(v.ValidationType)
+			lower, upper := rules[parser.MinSize], rules[parser.MaxSize]
+			switch {
+			case lower != nil && upper != nil:
+				if rule == lower {
+					line = []span{text(noun + " between "), value(lower.Specified), text(" and "), value(upper.Specified)}
+				}
+			case rule.Key == parser.MinSize:
+				line = []span{text(noun + " at least "), value(rule.Specified)}
+			default:
+				line = []span{text(noun + " at most "), value(rule.Specified)}
+			}
+		case parser.In, parser.NotIn:
+			if rule.Key == parser.In {
+				line = []span{text("one of ")}
+			} else {
+				line = []span{text("none of ")}
+			}
+			for i, val := range rule.Range {
+				if i > 0 {
+					line = append(line, text(", "))
+				}
+				line = append(line, value(val))
+			}
+		case parser.MultipleOf:
+			line = []span{text("must be a multiple of "), value(rule.Specified)}
+		case parser.Finite:
+			if flag(rule) {
+				line = []span{text("must be finite")}
+			}
+		case parser.Pattern:
+			line = []span{text("must match "), value(rule.Specified)}
+		case parser.Prefix:
+			line = []span{text("must start with "), value(rule.Specified)}
+		case parser.Suffix:
+			line = []span{text("must end with "), value(rule.Specified)}
+		case parser.Contains:
+			line = []span{text("must contain "), value(rule.Specified)}
+		case parser.NotContains:
+			line = []span{text("must not contain "), value(rule.Specified)}
+		case parser.DefinedOnly:
+			if flag(rule) {
+				line = []span{text("must be a defined value of "), code(string(f.Enum.Desc.FullName()))}
+			}
+		case parser.NotNil:
+			if flag(rule) {
+				line = []span{text("required")}
+			}
+		case parser.NoSparse:
+			if flag(rule) {
+				line = []span{text("values must not be nil")}
+			}
+		case parser.Skip:
+			if flag(rule) {
+				line = []span{text("nested rules are skipped")}
+			}
+		case parser.Elem:
+			line = inner("each item: ", f, rule.Inner, severity)
+		case parser.MapKey:
+			line = inner("each key: ", f.Message.Fields[0], rule.Inner, severity)
+		case parser.MapValue:
+			line = inner("each value: ", f.Message.Fields[1], rule.Inner, severity)
+		default:
+			line = []span{text(parser.KeyString[rule.Key] + " "), value(rule.Specified)}
+		}
+		if line != nil && rule.Inner == nil && rule.IsWarn(severity) {
+			line = append(line, warnSpan)
+		}
+		if line != nil {
+			ret = append(ret, line)
+		}
+	}
+	return ret
+}
+
+// inner joins the constraints of the elements, keys or values into one line.
+func inner(prefix string, f *protogen.Field, v *parser.Validation, severity string) []span {
+	lines := describe(f, v, severity)
+	if len(lines) == 0 {
+		return nil
+	}
+	ret := []span{text(prefix)}
+	for i, line := range lines {
+		if i > 0 {
+			ret = append(ret, text("; "))
+		}
+		ret = append(ret, line...)
+	}
+	return ret
+}
+
+func sizeNoun(vt parser.ValidationType) string {
+	switch vt {
+	case parser.ListValidation:
+		return "number of items"
+	case parser.MapValidation:
+		return "number of entries"
+	default:
+		return "length"
+	}
+}
+
+// flag reports whether a boolean rule is on, rules given by references or
+// functions are decided at runtime and count as on.
+func flag(rule *parser.Rule) bool {
+	return rule.Specified.ValueType != parser.BoolValue || rule.Specified.TypedValue.Bool
+}
+
+// warnSpan marks the constraints of the warn rules, which are reported but don't
+// fail the validation.
+var warnSpan = text(" (warn)")
+
+func value(v *parser.ValidationValue) span {
+	return code(v.String())
+}
@@ -0,0 +1,297 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doc
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	FormatMarkdown = "md"
+	FormatHTML     = "html"
+)
+
+// Generator writes a document of the constraints for every proto package, the
+// messages of all the files in a package go to one <package>.md or <package>.html.
+type Generator struct {
+	*protogen.Plugin
+	Format string
+
+	parser   *parser.Parser
+	severity string
+	order    []string
+	files    map[string][]*protogen.File
+	anchors  map[protoreflect.FullName]bool
+}
+
+func NewGenerator(plu *protogen.Plugin, format string) (*Generator, error) {
+	if format != FormatMarkdown && format != FormatHTML {
+		return nil, fmt.Errorf("unknown doc format %s, expect %s or %s", format, FormatMarkdown, FormatHTML)
+	}
+	return &Generator{
+		Plugin: plu,
+		Format: format,
+		parser: parser.NewParser(),
+		files:  make(map[string][]*protogen.File),
+	}, nil
+}
+
+// SetSeverity sets the severity of the rules without one, error or warn, like the
+// severity parameter of the plugin. The warn rules are marked in the documents.
+func (g *Generator) SetSeverity(severity string) {
+	g.severity = severity
+}
+
+// Add adds a file to the document of its package.
+func (g *Generator) Add(file *protogen.File) {
+	pkg := string(file.Desc.Package())
+	if _, ok := g.files[pkg]; !ok {
+		g.order = append(g.order, pkg)
+	}
+	g.files[pkg] = append(g.files[pkg], file)
+}
+
+func (g *Generator) Generate() error {
+	var pkgs []*packageDoc
+	for _, name := range g.order {
+		pkg, err := g.buildPackage(name, g.files[name])
+		if err != nil {
+			return err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	for _, pkg := range pkgs {
+		first := g.files[pkg.Name][0]
+		name := pkg.Name
+		if name == "" {
+			name = strings.TrimSuffix(path.Base(first.Desc.Path()), ".proto")
+		}
+		genFile := g.NewGeneratedFile(path.Join(path.Dir(first.GeneratedFilenamePrefix), name+"."+g.Format), "")
+		if g.Format == FormatHTML {
+			genFile.Write(renderHTML(pkg))
+		} else {
+			genFile.Write(renderMarkdown(pkg))
+		}
+	}
+	return nil
+}
+
+type packageDoc struct {
+	Name     string
+	Files    []string
+	Messages []*messageDoc
+	Enums    []*enumDoc
+}
+
+type messageDoc struct {
+	Name    string // full name, used as the anchor
+	Title   string // name relative to the package
+	Comment string
+	Fields  []*fieldDoc
+	Asserts [][]span
+	Oneofs  [][]span // the required oneofs
+}
+
+type fieldDoc struct {
+	Name        string
+	Type        []span
+	Comment     string
+	Constraints [][]span
+}
+
+type enumDoc struct {
+	Name    string
+	Title   string
+	Comment string
+	Values  []string
+}
+
+// span is a piece of text in a cell, code spans are rule values and links refer
+// to the messages and enums of the document.
+type span struct {
+	Text string
+	Code bool
+	Link string
+}
+
+func text(s string) span {
+	return span{Text: s}
+}
+
+func code(s string) span {
+	return span{Text: s, Code: true}
+}
+
+func (g *Generator) buildPackage(name string, files []*protogen.File) (*packageDoc, error) {
+	pkg := &packageDoc{Name: name}
+	// only the messages and enums of this document can be linked
+	g.anchors = make(map[protoreflect.FullName]bool)
+	var msgs []*protogen.Message
+	var enums []*protogen.Enum
+	var walk func(ms []*protogen.Message)
+	walk = func(ms []*protogen.Message) {
+		for _, msg := range ms {
+			if msg.Desc.IsMapEntry() {
+				continue
+			}
+			msgs = append(msgs, msg)
+			g.anchors[msg.Desc.FullName()] = true
+			for _, enum := range msg.Enums {
+				enums = append(enums, enum)
+				g.anchors[enum.Desc.FullName()] = true
+			}
+			walk(msg.Messages)
+		}
+	}
+	for _, f := range files {
+		pkg.Files = append(pkg.Files, f.Desc.Path())
+		for _, enum := range f.Enums {
+			enums = append(enums, enum)
+			g.anchors[enum.Desc.FullName()] = true
+		}
+		walk(f.Messages)
+	}
+	for _, msg := range msgs {
+		md, err := g.buildMessage(name, msg)
+		if err != nil {
+			return nil, fmt.Errorf("generate doc for %s failed: %w", msg.Desc.FullName(), err)
+		}
+		pkg.Messages = append(pkg.Messages, md)
+	}
+	for _, enum := range enums {
+		ed := &enumDoc{
+			Name:    string(enum.Desc.FullName()),
+			Title:   relativeName(name, enum.Desc.FullName()),
+			Comment: comment(enum.Comments.Leading),
+		}
+		for _, v := range enum.Values {
+			ed.Values = append(ed.Values, fmt.Sprintf("%s = %d", v.Desc.Name(), v.Desc.Number()))
+		}
+		pkg.Enums = append(pkg.Enums, ed)
+	}
+	return pkg, nil
+}
+
+func (g *Generator) buildMessage(pkg string, msg *protogen.Message) (*messageDoc, error) {
+	msgValidation, fieldValidations, err := g.parser.Parse(msg)
+	if err != nil {
+		return nil, err
+	}
+	md := &messageDoc{
+		Name:    string(msg.Desc.FullName()),
+		Title:   relativeName(pkg, msg.Desc.FullName()),
+		Comment: comment(msg.Comments.Leading),
+	}
+	for _, f := range msg.Fields {
+		fd := &fieldDoc{
+			Name:    string(f.Desc.Name()),
+			Type:    g.typeSpans(f),
+			Comment: comment(f.Comments.Leading),
+		}
+		if v := fieldValidations[f.Desc.Number()]; v != nil {
+			fd.Constraints = describe(f, v, g.severity)
+			if v.When != nil && len(fd.Constraints) > 0 {
+				fd.Constraints = append([][]span{{text("only when "), code(v.When.String())}}, fd.Constraints...)
+			}
+		}
+		md.Fields = append(md.Fields, fd)
+	}
+	for _, rule := range msgValidation.Rules {
+		switch rule.Key {
+		case parser.Assert:
+			line := []span{code(rule.Specified.String())}
+			if rule.IsWarn(g.severity) {
+				line = append(line, warnSpan)
+			}
+			md.Asserts = append(md.Asserts, line)
+		case parser.OneofRequired:
+			line := []span{text("one of ")}
+			for _, oneof := range msg.Oneofs {
+				if string(oneof.Desc.Name()) != rule.Specified.TypedValue.Binary {
+					continue
+				}
+				for i, f := range oneof.Fields {
+					if i > 0 {
+						line = append(line, text(", "))
+					}
+					line = append(line, code(string(f.Desc.Name())))
+				}
+			}
+			line = append(line, text(" must be set"))
+			if rule.IsWarn(g.severity) {
+				line = append(line, warnSpan)
+			}
+			md.Oneofs = append(md.Oneofs, line)
+		default:
+			return nil, fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return md, nil
+}
+
+func (g *Generator) typeSpans(f *protogen.Field) []span {
+	switch {
+	case f.Desc.IsMap():
+		ret := []span{text("map<")}
+		ret = append(ret, g.singularType(f.Message.Fields[0])...)
+		ret = append(ret, text(", "))
+		ret = append(ret, g.singularType(f.Message.Fields[1])...)
+		return append(ret, text(">"))
+	case f.Desc.IsList():
+		return append([]span{text("repeated ")}, g.singularType(f)...)
+	case f.Desc.HasOptionalKeyword():
+		return append([]span{text("optional ")}, g.singularType(f)...)
+	default:
+		return g.singularType(f)
+	}
+}
+
+func (g *Generator) singularType(f *protogen.Field) []span {
+	var name protoreflect.FullName
+	switch f.Desc.Kind() {
+	case protoreflect.EnumKind:
+		name = f.Enum.Desc.FullName()
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		name = f.Message.Desc.FullName()
+	default:
+		return []span{text(f.Desc.Kind().String())}
+	}
+	s := span{Text: string(name)}
+	if g.anchors[name] {
+		s.Link = string(name)
+	}
+	return []span{s}
+}
+
+func relativeName(pkg string, name protoreflect.FullName) string {
+	if pkg == "" {
+		return string(name)
+	}
+	return strings.TrimPrefix(string(name), pkg+".")
+}
+
+func comment(c protogen.Comments) string {
+	lines := strings.Split(strings.TrimSpace(string(c)), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, " ")
+}
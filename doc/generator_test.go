@@ -0,0 +1,49 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doc
+
+import (
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, format := range []string{FormatMarkdown, FormatHTML} {
+		t.Run(format, func(t *testing.T) {
+			gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+			g, err := NewGenerator(gen, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, f := range gen.Files {
+				if f.Generate {
+					g.Add(f)
+				}
+			}
+			if err := g.Generate(); err != nil {
+				t.Fatal(err)
+			}
+			plugintest.Golden(t, gen, "testdata")
+		})
+	}
+}
+
+func TestNewGenerator(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	if _, err := NewGenerator(gen, "pdf"); err == nil {
+		t.Error("NewGenerator() with an unknown format should fail")
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doc
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/validator"
+)
+
+func renderMarkdown(pkg *packageDoc) []byte {
+	var buf bytes.Buffer
+	p := func(format string, a ...interface{}) {
+		fmt.Fprintf(&buf, format, a...)
+		buf.WriteByte('\n')
+	}
+	p("<!-- Code generated by protoc-gen-validator %s. DO NOT EDIT. -->", validator.Version)
+	p("# Package %s", mdCode(pkg.Name))
+	p("")
+	p("Source: %s", mdEscape(strings.Join(pkg.Files, ", ")))
+	if len(pkg.Messages) > 0 {
+		p("")
+		p("## Messages")
+	}
+	for _, msg := range pkg.Messages {
+		p("")
+		p(`<a name="%s"></a>`, html.EscapeString(msg.Name))
+		p("")
+		p("### %s", mdEscape(msg.Title))
+		if msg.Comment != "" {
+			p("")
+			p("%s", mdEscape(msg.Comment))
+		}
+		if len(msg.Fields) > 0 {
+			p("")
+			p("| Field | Type | Constraints | Description |")
+			p("| --- | --- | --- | --- |")
+			for _, f := range msg.Fields {
+				var constraints []string
+				for _, line := range f.Constraints {
+					constraints = append(constraints, mdSpans(line))
+				}
+				p("| %s | %s | %s | %s |", mdEscape(f.Name), mdSpans(f.Type), strings.Join(constraints, "<br>"), mdEscape(f.Comment))
+			}
+		}
+		if len(msg.Oneofs) > 0 {
+			p("")
+			p("Required oneofs:")
+			p("")
+			for _, line := range msg.Oneofs {
+				p("- %s", mdSpans(line))
+			}
+		}
+		if len(msg.Asserts) > 0 {
+			p("")
+			p("Assertions:")
+			p("")
+			for _, assert := range msg.Asserts {
+				p("- %s", mdSpans(assert))
+			}
+		}
+	}
+	if len(pkg.Enums) > 0 {
+		p("")
+		p("## Enums")
+	}
+	for _, enum := range pkg.Enums {
+		p("")
+		p(`<a name="%s"></a>`, html.EscapeString(enum.Name))
+		p("")
+		p("### %s", mdEscape(enum.Title))
+		if enum.Comment != "" {
+			p("")
+			p("%s", mdEscape(enum.Comment))
+		}
+		p("")
+		for _, v := range enum.Values {
+			p("- %s", mdCode(v))
+		}
+	}
+	return buf.Bytes()
+}
+
+func mdSpans(spans []span) string {
+	var b strings.Builder
+	for _, s := range spans {
+		switch {
+		case s.Link != "":
+			fmt.Fprintf(&b, "[%s](#%s)", mdEscape(s.Text), s.Link)
+		case s.Code:
+			b.WriteString(mdCode(s.Text))
+		default:
+			b.WriteString(mdEscape(s.Text))
+		}
+	}
+	return b.String()
+}
+
+var mdReplacer = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", "&lt;", ">", "&gt;", "|", `\|`,
+)
+
+func mdEscape(s string) string {
+	return mdReplacer.Replace(s)
+}
+
+// mdCode returns a code span, the fence is longer than the backticks in s and the
+// pipes are escaped for the tables.
+func mdCode(s string) string {
+	fence := "`"
+	for strings.Contains(s, fence) {
+		fence += "`"
+	}
+	s = strings.ReplaceAll(s, "|", `\|`)
+	if strings.HasPrefix(s, "`") || strings.HasSuffix(s, "`") {
+		s = " " + s + " "
+	}
+	return fence + s + fence
+}
+
+const htmlStyle = `body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; vertical-align: top; }
+code { background: #f4f4f4; padding: 0 2px; }`
+
+func renderHTML(pkg *packageDoc) []byte {
+	var buf bytes.Buffer
+	p := func(format string, a ...interface{}) {
+		fmt.Fprintf(&buf, format, a...)
+		buf.WriteByte('\n')
+	}
+	e := html.EscapeString
+	p("<!DOCTYPE html>")
+	p("<!-- Code generated by protoc-gen-validator %s. DO NOT EDIT. -->", validator.Version)
+	p("<html>")
+	p("<head>")
+	p(`<meta charset="utf-8">`)
+	p("<title>Package %s</title>", e(pkg.Name))
+	p("<style>\n%s\n</style>", htmlStyle)
+	p("</head>")
+	p("<body>")
+	p("<h1>Package <code>%s</code></h1>", e(pkg.Name))
+	p("<p>Source: %s</p>", e(strings.Join(pkg.Files, ", ")))
+	if len(pkg.Messages) > 0 {
+		p("<h2>Messages</h2>")
+	}
+	for _, msg := range pkg.Messages {
+		p(`<h3 id="%s">%s</h3>`, e(msg.Name), e(msg.Title))
+		if msg.Comment != "" {
+			p("<p>%s</p>", e(msg.Comment))
+		}
+		if len(msg.Fields) > 0 {
+			p("<table>")
+			p("<tr><th>Field</th><th>Type</th><th>Constraints</th><th>Description</th></tr>")
+			for _, f := range msg.Fields {
+				var constraints []string
+				for _, line := range f.Constraints {
+					constraints = append(constraints, htmlSpans(line))
+				}
+				p("<tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td></tr>", e(f.Name), htmlSpans(f.Type), strings.Join(constraints, "<br>"), e(f.Comment))
+			}
+			p("</table>")
+		}
+		if len(msg.Oneofs) > 0 {
+			p("<p>Required oneofs:</p>")
+			p("<ul>")
+			for _, line := range msg.Oneofs {
+				p("<li>%s</li>", htmlSpans(line))
+			}
+			p("</ul>")
+		}
+		if len(msg.Asserts) > 0 {
+			p("<p>Assertions:</p>")
+			p("<ul>")
+			for _, assert := range msg.Asserts {
+				p("<li>%s</li>", htmlSpans(assert))
+			}
+			p("</ul>")
+		}
+	}
+	if len(pkg.Enums) > 0 {
+		p("<h2>Enums</h2>")
+	}
+	for _, enum := range pkg.Enums {
+		p(`<h3 id="%s">%s</h3>`, e(enum.Name), e(enum.Title))
+		if enum.Comment != "" {
+			p("<p>%s</p>", e(enum.Comment))
+		}
+		p("<ul>")
+		for _, v := range enum.Values {
+			p("<li><code>%s</code></li>", e(v))
+		}
+		p("</ul>")
+	}
+	p("</body>")
+	p("</html>")
+	return buf.Bytes()
+}
+
+func htmlSpans(spans []span) string {
+	var b strings.Builder
+	for _, s := range spans {
+		switch {
+		case s.Link != "":
+			fmt.Fprintf(&b, `<a href="#%s">%s</a>`, html.EscapeString(s.Link), html.EscapeString(s.Text))
+		case s.Code:
+			fmt.Fprintf(&b, "<code>%s</code>", html.EscapeString(s.Text))
+		default:
+			b.WriteString(html.EscapeString(s.Text))
+		}
+	}
+	return b.String()
+}
@@ -1,14 +1,16 @@
 // Code generated by protoc-gen-validator. DO NOT EDIT.
 // versions:
-// 	protoc-gen-validator v0.1.0
-// source: psm.proto
+// 	protoc-gen-validator v0.1.2
+// source: psm/psm.proto
 
 package psm
 
 import (
 	other "a/b/c/biz/model/other"
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
+	vt "github.com/cloudwego/protoc-gen-validator/vt"
 	os "os"
 	reflect "reflect"
 	regexp "regexp"
@@ -28,353 +30,1180 @@ var (
 )
 
 func (m *IntValidate) Validate() error {
-	if m.GetInt32Const() != int32(123) {
-		return fmt.Errorf("field Int32Const not match const value, current value: %v", m.GetInt32Const())
-	}
-	if m.GetInt32Const() >= int32(132) {
-		return fmt.Errorf("field Int32Const lt rule failed, current value: %v", m.GetInt32Const())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *IntValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *IntValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *IntValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *IntValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("Int32Const") {
+		if m.GetInt32Const() != int32(123) {
+			return &vt.Violation{
+				ID:         "vt.int.const",
+				Field:      "Int32Const",
+				Name:       "Int32Const",
+				Value:      m.GetInt32Const(),
+				Constraint: "123",
+			}
+		}
+		if m.GetInt32Const() > int32(1232) {
+			return &vt.Violation{
+				ID:         "vt.int.le",
+				Field:      "Int32Const",
+				Name:       "Int32Const",
+				Value:      m.GetInt32Const(),
+				Constraint: "1232",
+			}
+		}
+		if m.GetInt32Const() >= int32(132) {
+			return &vt.Violation{
+				ID:         "vt.int.lt",
+				Field:      "Int32Const",
+				Name:       "Int32Const",
+				Value:      m.GetInt32Const(),
+				Constraint: "132",
+			}
+		}
 	}
-	if m.GetInt32Const() > int32(1232) {
-		return fmt.Errorf("field Int32Const le rule failed, current value: %v", m.GetInt32Const())
+	if mask.Has("SIntLt") {
+		if m.GetSIntLt() >= int32(123) {
+			return &vt.Violation{
+				ID:         "vt.int.lt",
+				Field:      "SIntLt",
+				Name:       "SIntLt",
+				Value:      m.GetSIntLt(),
+				Constraint: "123",
+			}
+		}
 	}
-	if m.GetSIntLt() >= int32(123) {
-		return fmt.Errorf("field SIntLt lt rule failed, current value: %v", m.GetSIntLt())
+	if mask.Has("SFix32Lte") {
+		if m.GetSFix32Lte() > int32(123) {
+			return &vt.Violation{
+				ID:         "vt.int.le",
+				Field:      "SFix32Lte",
+				Name:       "SFix32Lte",
+				Value:      m.GetSFix32Lte(),
+				Constraint: "123",
+			}
+		}
 	}
-	if m.GetSFix32Lte() > int32(123) {
-		return fmt.Errorf("field SFix32Lte le rule failed, current value: %v", m.GetSFix32Lte())
+	if mask.Has("UIntGt") {
+		if m.GetUIntGt() <= uint32(123) {
+			return &vt.Violation{
+				ID:         "vt.uint.gt",
+				Field:      "UIntGt",
+				Name:       "UIntGt",
+				Value:      m.GetUIntGt(),
+				Constraint: "123",
+			}
+		}
 	}
-	if m.GetUIntGt() <= uint32(123) {
-		return fmt.Errorf("field UIntGt gt rule failed, current value: %v", m.GetUIntGt())
+	if mask.Has("uint64Gte") {
+		if m.Uint64Gte == nil {
+			return &vt.Violation{
+				ID:         "vt.uint.not_nil",
+				Field:      "uint64Gte",
+				Name:       "uint64Gte",
+				Constraint: "true",
+			}
+		}
+		if m.GetUint64Gte() < uint64(123) {
+			return &vt.Violation{
+				ID:         "vt.uint.ge",
+				Field:      "uint64Gte",
+				Name:       "uint64Gte",
+				Value:      m.GetUint64Gte(),
+				Constraint: "123",
+			}
+		}
 	}
-	if m.Uint64Gte == nil {
-		return fmt.Errorf("field uint64Gte not_nil rule failed")
+	if mask.Has("Fix32In") {
+		_src := []uint32{uint32(123), uint32(456), uint32(789)}
 
+		var _exist bool
+		for _, src := range _src {
+			if m.GetFix32In() == uint32(src) {
+				_exist = true
+				break
+			}
+		}
+		if !_exist {
+			return &vt.Violation{
+				ID:         "vt.uint.in",
+				Field:      "Fix32In",
+				Name:       "Fix32In",
+				Value:      m.GetFix32In(),
+				Constraint: "[123, 456, 789]",
+			}
+		}
 	}
-	if m.GetUint64Gte() < uint64(123) {
-		return fmt.Errorf("field uint64Gte ge rule failed, current value: %v", m.GetUint64Gte())
-	}
-	_src := []uint32{uint32(123), uint32(456), uint32(789)}
+	if mask.Has("Fix64Notin") {
+		_src1 := []uint64{uint64(123), uint64(456), uint64(789), uint64(m.GetSFix32Lte())}
 
-	var _exist bool
-	for _, src := range _src {
-		if m.GetFix32In() == uint32(src) {
-			_exist = true
-			break
+		for _, src := range _src1 {
+			if m.GetFix64Notin() == uint64(src) {
+				return &vt.Violation{
+					ID:         "vt.uint.not_in",
+					Field:      "Fix64Notin",
+					Name:       "Fix64Notin",
+					Value:      m.GetFix64Notin(),
+					Constraint: "[123, 456, 789, $SFix32Lte]",
+				}
+			}
 		}
 	}
-	if !_exist {
-		return fmt.Errorf("field Fix32In in rule failed, current value: %v", m.GetFix32In())
-	}
-	_src1 := []uint64{uint64(123), uint64(456), uint64(789), uint64(m.GetSFix32Lte())}
-
-	for _, src := range _src1 {
-		if m.GetFix64Notin() == uint64(src) {
-			return fmt.Errorf("field Fix64Notin not_in rule failed, current value: %v", m.GetFix64Notin())
+	if mask.Has("Reference") {
+		if m.GetReference() > int32(m.GetSIntLt()) {
+			return &vt.Violation{
+				ID:         "vt.int.le",
+				Field:      "Reference",
+				Name:       "Reference",
+				Value:      m.GetReference(),
+				Constraint: "$SIntLt",
+			}
 		}
 	}
-	if m.GetReference() > int32(m.GetSIntLt()) {
-		return fmt.Errorf("field Reference le rule failed, current value: %v", m.GetReference())
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *IntValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *DoubleValidate) Validate() error {
-	if m.GetDoubleConst() != float64(123.123) {
-		return fmt.Errorf("field DoubleConst not match const value, current value: %v", m.GetDoubleConst())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *DoubleValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *DoubleValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *DoubleValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *DoubleValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("DoubleConst") {
+		if m.GetDoubleConst() != float64(123.123) {
+			return &vt.Violation{
+				ID:         "vt.float.const",
+				Field:      "DoubleConst",
+				Name:       "DoubleConst",
+				Value:      m.GetDoubleConst(),
+				Constraint: "123.123",
+			}
+		}
 	}
-	if m.GetFloatLt() >= float32(123.312) {
-		return fmt.Errorf("field FloatLt lt rule failed, current value: %v", m.GetFloatLt())
+	if mask.Has("FloatLt") {
+		if !(m.GetFloatLt() < float32(123.312)) {
+			return &vt.Violation{
+				ID:         "vt.float.lt",
+				Field:      "FloatLt",
+				Name:       "FloatLt",
+				Value:      m.GetFloatLt(),
+				Constraint: "123.312",
+			}
+		}
 	}
-	if m.GetDoubleLe() > float64(123.54) {
-		return fmt.Errorf("field DoubleLe le rule failed, current value: %v", m.GetDoubleLe())
+	if mask.Has("DoubleLe") {
+		if !(m.GetDoubleLe() <= float64(123.54)) {
+			return &vt.Violation{
+				ID:         "vt.float.le",
+				Field:      "DoubleLe",
+				Name:       "DoubleLe",
+				Value:      m.GetDoubleLe(),
+				Constraint: "123.54",
+			}
+		}
 	}
-	if m.GetDoubleGt() <= float64(123.76) {
-		return fmt.Errorf("field DoubleGt gt rule failed, current value: %v", m.GetDoubleGt())
+	if mask.Has("DoubleGt") {
+		if !(m.GetDoubleGt() > float64(123.76)) {
+			return &vt.Violation{
+				ID:         "vt.float.gt",
+				Field:      "DoubleGt",
+				Name:       "DoubleGt",
+				Value:      m.GetDoubleGt(),
+				Constraint: "123.76",
+			}
+		}
 	}
-	if m.GetDoubleGe() < float64(123.32) {
-		return fmt.Errorf("field DoubleGe ge rule failed, current value: %v", m.GetDoubleGe())
+	if mask.Has("DoubleGe") {
+		if !(m.GetDoubleGe() >= float64(123.32)) {
+			return &vt.Violation{
+				ID:         "vt.float.ge",
+				Field:      "DoubleGe",
+				Name:       "DoubleGe",
+				Value:      m.GetDoubleGe(),
+				Constraint: "123.32",
+			}
+		}
 	}
-	_src := []float64{float64(123.9), float64(456.443), float64(789.232)}
+	if mask.Has("DoubleIn") {
+		_src := []float64{float64(123.9), float64(456.443), float64(789.232)}
 
-	var _exist bool
-	for _, src := range _src {
-		if m.GetDoubleIn() == float64(src) {
-			_exist = true
-			break
+		var _exist bool
+		for _, src := range _src {
+			if m.GetDoubleIn() == float64(src) {
+				_exist = true
+				break
+			}
+		}
+		if !_exist {
+			return &vt.Violation{
+				ID:         "vt.float.in",
+				Field:      "DoubleIn",
+				Name:       "DoubleIn",
+				Value:      m.GetDoubleIn(),
+				Constraint: "[123.9, 456.443, 789.232]",
+			}
 		}
 	}
-	if !_exist {
-		return fmt.Errorf("field DoubleIn in rule failed, current value: %v", m.GetDoubleIn())
-	}
-	_src1 := []float64{float64(123.234), float64(456.7654), float64(789.232), float64(m.GetDoubleLe())}
+	if mask.Has("DoubleNotin") {
+		_src1 := []float64{float64(123.234), float64(456.7654), float64(789.232), float64(m.GetDoubleLe())}
 
-	for _, src := range _src1 {
-		if m.GetDoubleNotin() == float64(src) {
-			return fmt.Errorf("field DoubleNotin not_in rule failed, current value: %v", m.GetDoubleNotin())
+		for _, src := range _src1 {
+			if m.GetDoubleNotin() == float64(src) {
+				return &vt.Violation{
+					ID:         "vt.float.not_in",
+					Field:      "DoubleNotin",
+					Name:       "DoubleNotin",
+					Value:      m.GetDoubleNotin(),
+					Constraint: "[123.234, 456.7654, 789.232, $DoubleLe]",
+				}
+			}
+		}
+	}
+	if mask.Has("Reference") {
+		if !(m.GetReference() <= float64(m.GetDoubleLe())) {
+			return &vt.Violation{
+				ID:         "vt.float.le",
+				Field:      "Reference",
+				Name:       "Reference",
+				Value:      m.GetReference(),
+				Constraint: "$DoubleLe",
+			}
 		}
 	}
-	if m.GetReference() > float64(m.GetDoubleLe()) {
-		return fmt.Errorf("field Reference le rule failed, current value: %v", m.GetReference())
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *DoubleValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *BoolValidator) Validate() error {
-	if m.GetBoolConst() != true {
-		return fmt.Errorf("field BoolConst const rule failed, current value: %v", m.GetBoolConst())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *BoolValidator) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *BoolValidator) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *BoolValidator) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *BoolValidator) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("BoolConst") {
+		if m.GetBoolConst() != true {
+			return &vt.Violation{
+				ID:         "vt.bool.const",
+				Field:      "BoolConst",
+				Name:       "BoolConst",
+				Value:      m.GetBoolConst(),
+				Constraint: "true",
+			}
+		}
 	}
-	if m.GetReference() != m.GetBoolConst() {
-		return fmt.Errorf("field Reference const rule failed, current value: %v", m.GetReference())
+	if mask.Has("Reference") {
+		if m.GetReference() != m.GetBoolConst() {
+			return &vt.Violation{
+				ID:         "vt.bool.const",
+				Field:      "Reference",
+				Name:       "Reference",
+				Value:      m.GetReference(),
+				Constraint: "$BoolConst",
+			}
+		}
+	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *BoolValidator) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *StringValidate) Validate() error {
-	_src := "asd"
-	if m.GetStringConst() != _src {
-		return fmt.Errorf("field StringConst not match const value, current value: %v", m.GetStringConst())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *StringValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *StringValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *StringValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *StringValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("StringConst") {
+		_src := "asd"
+		if m.GetStringConst() != _src {
+			return &vt.Violation{
+				ID:         "vt.string.const",
+				Field:      "StringConst",
+				Name:       "StringConst",
+				Value:      m.GetStringConst(),
+				Constraint: "asd",
+			}
+		}
 	}
-	if len(m.GetStringMinSize()) < int(12) {
-		return fmt.Errorf("field StringMinSize min_len rule failed, current value: %d", len(m.GetStringMinSize()))
+	if mask.Has("StringMinSize") {
+		if len(m.GetStringMinSize()) < int(12) {
+			return &vt.Violation{
+				ID:         "vt.string.min_size",
+				Field:      "StringMinSize",
+				Name:       "StringMinSize",
+				Value:      len(m.GetStringMinSize()),
+				Constraint: "12",
+			}
+		}
 	}
-	if len(m.GetStringMaxSize()) > int(12) {
-		return fmt.Errorf("field StringMaxSize max_len rule failed, current value: %d", len(m.GetStringMaxSize()))
+	if mask.Has("StringMaxSize") {
+		if len(m.GetStringMaxSize()) > int(12) {
+			return &vt.Violation{
+				ID:         "vt.string.max_size",
+				Field:      "StringMaxSize",
+				Name:       "StringMaxSize",
+				Value:      len(m.GetStringMaxSize()),
+				Constraint: "12",
+			}
+		}
 	}
-	_src1 := "[0-9A-Za-z]+"
-	if ok, _ := regexp.MatchString(_src1, m.GetStringPattern()); !ok {
-		return fmt.Errorf("field StringPattern pattern rule failed, current value: %v", m.GetStringPattern())
+	if mask.Has("StringPattern") {
+		_src1 := "[0-9A-Za-z]+"
+		if ok, _ := regexp.MatchString(_src1, m.GetStringPattern()); !ok {
+			return &vt.Violation{
+				ID:         "vt.string.pattern",
+				Field:      "StringPattern",
+				Name:       "StringPattern",
+				Value:      m.GetStringPattern(),
+				Constraint: "[0-9A-Za-z]+",
+			}
+		}
 	}
-	_src2 := "asd"
-	if !strings.HasPrefix(m.GetStringPrefix(), _src2) {
-		return fmt.Errorf("field StringPrefix prefix rule failed, current value: %v", m.GetStringPrefix())
+	if mask.Has("StringPrefix") {
+		_src2 := "asd"
+		if !strings.HasPrefix(m.GetStringPrefix(), _src2) {
+			return &vt.Violation{
+				ID:         "vt.string.prefix",
+				Field:      "StringPrefix",
+				Name:       "StringPrefix",
+				Value:      m.GetStringPrefix(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src3 := "asd"
-	if !strings.HasSuffix(m.GetStringSuffix(), _src3) {
-		return fmt.Errorf("field StringSuffix suffix rule failed, current value: %v", m.GetStringSuffix())
+	if mask.Has("StringSuffix") {
+		_src3 := "asd"
+		if !strings.HasSuffix(m.GetStringSuffix(), _src3) {
+			return &vt.Violation{
+				ID:         "vt.string.suffix",
+				Field:      "StringSuffix",
+				Name:       "StringSuffix",
+				Value:      m.GetStringSuffix(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src4 := "asd"
-	if !strings.Contains(m.GetStringContain(), _src4) {
-		return fmt.Errorf("field StringContain contains rule failed, current value: %v", m.GetStringContain())
+	if mask.Has("StringContain") {
+		_src4 := "asd"
+		if !strings.Contains(m.GetStringContain(), _src4) {
+			return &vt.Violation{
+				ID:         "vt.string.contains",
+				Field:      "StringContain",
+				Name:       "StringContain",
+				Value:      m.GetStringContain(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src5 := "asd"
-	if strings.Contains(m.GetStringNotContain(), _src5) {
-		return fmt.Errorf("field StringNotContain not_contains rule failed, current value: %v", m.GetStringNotContain())
+	if mask.Has("StringNotContain") {
+		_src5 := "asd"
+		if strings.Contains(m.GetStringNotContain(), _src5) {
+			return &vt.Violation{
+				ID:         "vt.string.not_contains",
+				Field:      "StringNotContain",
+				Name:       "StringNotContain",
+				Value:      m.GetStringNotContain(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src6 := []string{string("123"), string("456"), string("789")}
+	if mask.Has("StringIn") {
+		_src6 := []string{string("123"), string("456"), string("789")}
 
-	var _exist bool
-	for _, src := range _src6 {
-		if m.GetStringIn() == src {
-			_exist = true
-			break
+		var _exist bool
+		for _, src := range _src6 {
+			if m.GetStringIn() == src {
+				_exist = true
+				break
+			}
+		}
+		if !_exist {
+			return &vt.Violation{
+				ID:         "vt.string.in",
+				Field:      "StringIn",
+				Name:       "StringIn",
+				Value:      m.GetStringIn(),
+				Constraint: "[123, 456, 789]",
+			}
 		}
 	}
-	if !_exist {
-		return fmt.Errorf("field StringIn in rule failed, current value: %v", m.GetStringIn())
-	}
-	_src7 := []string{string("123"), string("456"), string("789")}
+	if mask.Has("StringNotIn") {
+		_src7 := []string{string("123"), string("456"), string("789")}
 
-	for _, src := range _src7 {
-		if m.GetStringNotIn() == src {
-			return fmt.Errorf("field StringNotIn not_in rule failed, current value: %v", m.GetStringNotIn())
+		for _, src := range _src7 {
+			if m.GetStringNotIn() == src {
+				return &vt.Violation{
+					ID:         "vt.string.not_in",
+					Field:      "StringNotIn",
+					Name:       "StringNotIn",
+					Value:      m.GetStringNotIn(),
+					Constraint: "[123, 456, 789]",
+				}
+			}
 		}
 	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
+	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *StringValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *BytesValidate) Validate() error {
-	_src := []byte("asd")
-	if !bytes.Equal(m.GetBytesConst(), _src) {
-		return fmt.Errorf("field bytesConst not match const value, current value: %v", m.GetBytesConst())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *BytesValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *BytesValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *BytesValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *BytesValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("bytesConst") {
+		_src := []byte("asd")
+		if !bytes.Equal(m.GetBytesConst(), _src) {
+			return &vt.Violation{
+				ID:         "vt.bytes.const",
+				Field:      "bytesConst",
+				Name:       "bytesConst",
+				Value:      m.GetBytesConst(),
+				Constraint: "asd",
+			}
+		}
 	}
-	if len(m.GetBytesMinSize()) < int(12) {
-		return fmt.Errorf("field bytesMinSize min_len rule failed, current value: %d", len(m.GetBytesMinSize()))
+	if mask.Has("bytesMinSize") {
+		if len(m.GetBytesMinSize()) < int(12) {
+			return &vt.Violation{
+				ID:         "vt.bytes.min_size",
+				Field:      "bytesMinSize",
+				Name:       "bytesMinSize",
+				Value:      len(m.GetBytesMinSize()),
+				Constraint: "12",
+			}
+		}
 	}
-	if len(m.GetBytesMaxSize()) > int(12) {
-		return fmt.Errorf("field bytesMaxSize max_len rule failed, current value: %d", len(m.GetBytesMaxSize()))
+	if mask.Has("bytesMaxSize") {
+		if len(m.GetBytesMaxSize()) > int(12) {
+			return &vt.Violation{
+				ID:         "vt.bytes.max_size",
+				Field:      "bytesMaxSize",
+				Name:       "bytesMaxSize",
+				Value:      len(m.GetBytesMaxSize()),
+				Constraint: "12",
+			}
+		}
 	}
-	_src1 := "[0-9A-Za-z]+"
-	if ok, _ := regexp.Match(string(_src1), m.GetBytesPattern()); !ok {
-		return fmt.Errorf("field bytesPattern pattern rule failed, current value: %v", m.GetBytesPattern())
+	if mask.Has("bytesPattern") {
+		_src1 := "[0-9A-Za-z]+"
+		if ok, _ := regexp.Match(string(_src1), m.GetBytesPattern()); !ok {
+			return &vt.Violation{
+				ID:         "vt.bytes.pattern",
+				Field:      "bytesPattern",
+				Name:       "bytesPattern",
+				Value:      m.GetBytesPattern(),
+				Constraint: "[0-9A-Za-z]+",
+			}
+		}
 	}
-	_src2 := []byte("asd")
-	if !bytes.HasPrefix(m.GetBytesPrefix(), _src2) {
-		return fmt.Errorf("field bytesPrefix prefix rule failed, current value: %v", m.GetBytesPrefix())
+	if mask.Has("bytesPrefix") {
+		_src2 := []byte("asd")
+		if !bytes.HasPrefix(m.GetBytesPrefix(), _src2) {
+			return &vt.Violation{
+				ID:         "vt.bytes.prefix",
+				Field:      "bytesPrefix",
+				Name:       "bytesPrefix",
+				Value:      m.GetBytesPrefix(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src3 := []byte("asd")
-	if !bytes.HasSuffix(m.GetBytesSuffix(), _src3) {
-		return fmt.Errorf("field bytesSuffix suffix rule failed, current value: %v", m.GetBytesSuffix())
+	if mask.Has("bytesSuffix") {
+		_src3 := []byte("asd")
+		if !bytes.HasSuffix(m.GetBytesSuffix(), _src3) {
+			return &vt.Violation{
+				ID:         "vt.bytes.suffix",
+				Field:      "bytesSuffix",
+				Name:       "bytesSuffix",
+				Value:      m.GetBytesSuffix(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src4 := []byte("asd")
-	if !bytes.Contains(m.GetBytesContain(), _src4) {
-		return fmt.Errorf("field bytesContain contains rule failed, current value: %v", m.GetBytesContain())
+	if mask.Has("bytesContain") {
+		_src4 := []byte("asd")
+		if !bytes.Contains(m.GetBytesContain(), _src4) {
+			return &vt.Violation{
+				ID:         "vt.bytes.contains",
+				Field:      "bytesContain",
+				Name:       "bytesContain",
+				Value:      m.GetBytesContain(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src5 := []byte("asd")
-	if bytes.Contains(m.GetBytesNotContain(), _src5) {
-		return fmt.Errorf("field bytesNotContain not_contains rule failed, current value: %v", m.GetBytesNotContain())
+	if mask.Has("bytesNotContain") {
+		_src5 := []byte("asd")
+		if bytes.Contains(m.GetBytesNotContain(), _src5) {
+			return &vt.Violation{
+				ID:         "vt.bytes.not_contains",
+				Field:      "bytesNotContain",
+				Name:       "bytesNotContain",
+				Value:      m.GetBytesNotContain(),
+				Constraint: "asd",
+			}
+		}
 	}
-	_src6 := []byte{byte("123"), byte("456"), byte("789")}
+	if mask.Has("bytesIn") {
+		_src6 := []byte{byte("123"), byte("456"), byte("789")}
 
-	var _exist bool
-	for _, src := range _src6 {
-		if bytes.Equal(m.GetBytesIn(), src) {
-			_exist = true
-			break
+		var _exist bool
+		for _, src := range _src6 {
+			if bytes.Equal(m.GetBytesIn(), src) {
+				_exist = true
+				break
+			}
+		}
+		if !_exist {
+			return &vt.Violation{
+				ID:         "vt.bytes.in",
+				Field:      "bytesIn",
+				Name:       "bytesIn",
+				Value:      m.GetBytesIn(),
+				Constraint: "[123, 456, 789]",
+			}
 		}
 	}
-	if !_exist {
-		return fmt.Errorf("field bytesIn in rule failed, current value: %v", m.GetBytesIn())
-	}
-	_src7 := []byte{byte("123"), byte("456"), byte("789")}
+	if mask.Has("bytesNotIn") {
+		_src7 := []byte{byte("123"), byte("456"), byte("789")}
 
-	for _, src := range _src7 {
-		if bytes.Equal(m.GetBytesNotIn(), src) {
-			return fmt.Errorf("field bytesNotIn not_in rule failed, current value: %v", m.GetBytesNotIn())
+		for _, src := range _src7 {
+			if bytes.Equal(m.GetBytesNotIn(), src) {
+				return &vt.Violation{
+					ID:         "vt.bytes.not_in",
+					Field:      "bytesNotIn",
+					Name:       "bytesNotIn",
+					Value:      m.GetBytesNotIn(),
+					Constraint: "[123, 456, 789]",
+				}
+			}
 		}
 	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
+	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *BytesValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *EnumValidate) Validate() error {
-	_src := EnumType_TWEET
-	if m.GetEnum1() != _src {
-		return fmt.Errorf("field Enum1 const rule failed, current value: %v", m.GetEnum1())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *EnumValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *EnumValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *EnumValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *EnumValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("Enum1") {
+		_src := EnumType_TWEET
+		if m.GetEnum1() != _src {
+			return &vt.Violation{
+				ID:         "vt.enum.const",
+				Field:      "Enum1",
+				Name:       "Enum1",
+				Value:      m.GetEnum1(),
+				Constraint: "EnumType.TWEET",
+			}
+		}
 	}
-	_src1 := EnumType2_TWEET2
-	if m.GetEnum2() != _src1 {
-		return fmt.Errorf("field Enum2 const rule failed, current value: %v", m.GetEnum2())
+	if mask.Has("Enum2") {
+		_src1 := EnumType2_TWEET2
+		if m.GetEnum2() != _src1 {
+			return &vt.Violation{
+				ID:         "vt.enum.const",
+				Field:      "Enum2",
+				Name:       "Enum2",
+				Value:      m.GetEnum2(),
+				Constraint: "EnumType2.TWEET2",
+			}
+		}
+	}
+	if mask.Has("Enum3") {
+		_src2 := other.OtherEnumType_TWEET
+		if m.GetEnum3() != _src2 {
+			return &vt.Violation{
+				ID:         "vt.enum.const",
+				Field:      "Enum3",
+				Name:       "Enum3",
+				Value:      m.GetEnum3(),
+				Constraint: "other.OtherEnumType.TWEET",
+			}
+		}
 	}
-	_src2 := other.OtherEnumType_TWEET
-	if m.GetEnum3() != _src2 {
-		return fmt.Errorf("field Enum3 const rule failed, current value: %v", m.GetEnum3())
+	if mask.Has("EnumDefineOnly") {
+		if _, ok := EnumType_name[int32(m.GetEnumDefineOnly())]; !ok {
+			return &vt.Violation{
+				ID:         "vt.enum.defined_only",
+				Field:      "EnumDefineOnly",
+				Name:       "EnumDefineOnly",
+				Value:      m.GetEnumDefineOnly(),
+				Constraint: "true",
+			}
+		}
 	}
-	if _, ok := EnumType_name[int32(m.GetEnumDefineOnly())]; !ok {
-		return fmt.Errorf("field EnumDefineOnly defined_only rule failed")
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *EnumValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *ListValidate) Validate() error {
-	if len(m.GetListMinSize()) < int(12) {
-		return fmt.Errorf("field ListMinSize MinLen rule failed, current value: %v", m.GetListMinSize())
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *ListValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *ListValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *ListValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *ListValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("ListMinSize") {
+		if len(m.GetListMinSize()) < int(12) {
+			return &vt.Violation{
+				ID:         "vt.repeated.min_size",
+				Field:      "ListMinSize",
+				Name:       "ListMinSize",
+				Value:      len(m.GetListMinSize()),
+				Constraint: "12",
+			}
+		}
 	}
-	if len(m.GetListMaxSize()) > int(11) {
-		return fmt.Errorf("field ListMaxSize MaxLen rule failed, current value: %v", m.GetListMaxSize())
+	if mask.Has("ListMaxSize") {
+		if len(m.GetListMaxSize()) > int(11) {
+			return &vt.Violation{
+				ID:         "vt.repeated.max_size",
+				Field:      "ListMaxSize",
+				Name:       "ListMaxSize",
+				Value:      len(m.GetListMaxSize()),
+				Constraint: "11",
+			}
+		}
 	}
-	for i := 0; i < len(m.GetListBaseElem()); i++ {
-		_elem := m.GetListBaseElem()[i]
-		_src := "312"
-		if _elem != _src {
-			return fmt.Errorf("field _elem not match const value, current value: %v", _elem)
+	if mask.Has("ListBaseElem") {
+		for i := 0; i < len(m.GetListBaseElem()); i++ {
+			_elem := m.GetListBaseElem()[i]
+			_src := "312"
+			if _elem != _src {
+				return &vt.Violation{
+					ID:         "vt.string.const",
+					Field:      "ListBaseElem",
+					Name:       "ListBaseElem",
+					Value:      _elem,
+					Constraint: "312",
+				}
+			}
 		}
 	}
-	for i := 0; i < len(m.GetListMsgElem()); i++ {
-		_elem1 := m.GetListMsgElem()[i]
-		if err := _elem1.Validate(); err != nil {
-			return fmt.Errorf("filed _elem1 not valid, %w", err)
+	if mask.Has("ListMsgElem") {
+		for i := 0; i < len(m.GetListMsgElem()); i++ {
+			_elem1 := m.GetListMsgElem()[i]
+			if _elem1 != nil {
+				if err := vt.ValidateMaskedContext(ctx, _elem1, mask.Sub("ListMsgElem")); err != nil {
+					return vt.NestedIndex("ListMsgElem", i, err)
+				}
+			}
 		}
 	}
-	for i := 0; i < len(m.GetListEnum()); i++ {
-		_elem2 := m.GetListEnum()[i]
-		_src1 := other.OtherEnumType_TWEET
-		if _elem2 != _src1 {
-			return fmt.Errorf("field _elem2 const rule failed, current value: %v", _elem2)
+	if mask.Has("ListEnum") {
+		for i := 0; i < len(m.GetListEnum()); i++ {
+			_elem2 := m.GetListEnum()[i]
+			_src1 := other.OtherEnumType_TWEET
+			if _elem2 != _src1 {
+				return &vt.Violation{
+					ID:         "vt.enum.const",
+					Field:      "ListEnum",
+					Name:       "ListEnum",
+					Value:      _elem2,
+					Constraint: "other.OtherEnumType.TWEET",
+				}
+			}
 		}
 	}
-	for i := 0; i < len(m.GetListEnum2()); i++ {
-		_elem3 := m.GetListEnum2()[i]
-		_src2 := EnumType_TWEET
-		if _elem3 != _src2 {
-			return fmt.Errorf("field _elem3 const rule failed, current value: %v", _elem3)
+	if mask.Has("ListEnum2") {
+		for i := 0; i < len(m.GetListEnum2()); i++ {
+			_elem3 := m.GetListEnum2()[i]
+			_src2 := EnumType_TWEET
+			if _elem3 != _src2 {
+				return &vt.Violation{
+					ID:         "vt.enum.const",
+					Field:      "ListEnum2",
+					Name:       "ListEnum2",
+					Value:      _elem3,
+					Constraint: "EnumType.TWEET",
+				}
+			}
 		}
 	}
-	for i := 0; i < len(m.GetListBaseElemIn()); i++ {
-		_elem4 := m.GetListBaseElemIn()[i]
-		_src3 := []string{string("123"), string("456"), string("789")}
+	if mask.Has("ListBaseElemIn") {
+		for i := 0; i < len(m.GetListBaseElemIn()); i++ {
+			_elem4 := m.GetListBaseElemIn()[i]
+			_src3 := []string{string("123"), string("456"), string("789")}
 
-		for _, src := range _src3 {
-			if _elem4 == src {
-				return fmt.Errorf("field _elem4 not_in rule failed, current value: %v", _elem4)
+			for _, src := range _src3 {
+				if _elem4 == src {
+					return &vt.Violation{
+						ID:         "vt.string.not_in",
+						Field:      "ListBaseElemIn",
+						Name:       "ListBaseElemIn",
+						Value:      _elem4,
+						Constraint: "[123, 456, 789]",
+					}
+				}
 			}
 		}
 	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
+	}
 	return nil
 }
 
-func (m *MapValidate) Validate() error {
-	if len(m.GetMapISMinSize()) < int(10) {
-		return fmt.Errorf("field MapISMinSize min_size rule failed, current value: %v", m.GetMapISMinSize())
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *ListValidate) Normalize() {
+	if m == nil {
+		return
 	}
-	if len(m.GetMapISMinSize()) > int(30) {
-		return fmt.Errorf("field MapISMinSize max_size rule failed, current value: %v", m.GetMapISMinSize())
+	for _, v := range m.ListMsgElem {
+		vt.Normalize(v)
 	}
-	for _, v := range m.GetMapNoSparse() {
-		if v == nil {
-			return fmt.Errorf("field MapNoSparse no_sparse rule failed, current value: %v", m.GetMapNoSparse())
+}
+
+func (m *MapValidate) Validate() error {
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *MapValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *MapValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *MapValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *MapValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("MapISMinSize") {
+		if len(m.GetMapISMinSize()) > int(30) {
+			return &vt.Violation{
+				ID:         "vt.map.max_size",
+				Field:      "MapISMinSize",
+				Name:       "MapISMinSize",
+				Value:      len(m.GetMapISMinSize()),
+				Constraint: "30",
+			}
 		}
-	}
-	for k := range m.GetMapISKeyValue() {
-		if k != int32(123) {
-			return fmt.Errorf("field k not match const value, current value: %v", k)
+		if len(m.GetMapISMinSize()) < int(10) {
+			return &vt.Violation{
+				ID:         "vt.map.min_size",
+				Field:      "MapISMinSize",
+				Name:       "MapISMinSize",
+				Value:      len(m.GetMapISMinSize()),
+				Constraint: "10",
+			}
 		}
-		if k <= int32(12) {
-			return fmt.Errorf("field k gt rule failed, current value: %v", k)
+	}
+	if mask.Has("MapNoSparse") {
+		for _, v := range m.GetMapNoSparse() {
+			if v == nil {
+				return &vt.Violation{
+					ID:         "vt.map.no_sparse",
+					Field:      "MapNoSparse",
+					Name:       "MapNoSparse",
+					Value:      m.GetMapNoSparse(),
+					Constraint: "true",
+				}
+			}
 		}
 	}
-	for _, v := range m.GetMapISKeyValue() {
-		_src := "asd"
-		if v != _src {
-			return fmt.Errorf("field v not match const value, current value: %v", v)
+	if mask.Has("MapISKeyValue") {
+		for k := range m.GetMapISKeyValue() {
+			if k != int32(123) {
+				return &vt.Violation{
+					ID:         "vt.int.const",
+					Field:      "MapISKeyValue",
+					Name:       "MapISKeyValue",
+					Value:      k,
+					Constraint: "123",
+				}
+			}
+			if k <= int32(12) {
+				return &vt.Violation{
+					ID:         "vt.int.gt",
+					Field:      "MapISKeyValue",
+					Name:       "MapISKeyValue",
+					Value:      k,
+					Constraint: "12",
+				}
+			}
 		}
-		_src1 := "asd"
-		if !strings.HasPrefix(v, _src1) {
-			return fmt.Errorf("field v prefix rule failed, current value: %v", v)
+		for _, v := range m.GetMapISKeyValue() {
+			_src := "asd"
+			if v != _src {
+				return &vt.Violation{
+					ID:         "vt.string.const",
+					Field:      "MapISKeyValue",
+					Name:       "MapISKeyValue",
+					Value:      v,
+					Constraint: "asd",
+				}
+			}
+			_src1 := "asd"
+			if !strings.HasPrefix(v, _src1) {
+				return &vt.Violation{
+					ID:         "vt.string.prefix",
+					Field:      "MapISKeyValue",
+					Name:       "MapISKeyValue",
+					Value:      v,
+					Constraint: "asd",
+				}
+			}
 		}
 	}
-	for _, v := range m.GetEnumType11() {
-		_src2 := other.OtherEnumType_TWEET
-		if v != _src2 {
-			return fmt.Errorf("field v const rule failed, current value: %v", v)
+	if mask.Has("EnumType11") {
+		for _, v := range m.GetEnumType11() {
+			_src2 := other.OtherEnumType_TWEET
+			if v != _src2 {
+				return &vt.Violation{
+					ID:         "vt.enum.const",
+					Field:      "EnumType11",
+					Name:       "EnumType11",
+					Value:      v,
+					Constraint: "other.OtherEnumType.TWEET",
+				}
+			}
 		}
 	}
-	for _, v := range m.GetMapMsgKeyValue() {
-		if err := v.Validate(); err != nil {
-			return fmt.Errorf("filed v not valid, %w", err)
+	if mask.Has("MapMsgKeyValue") {
+		for k, v := range m.GetMapMsgKeyValue() {
+			if v != nil {
+				if err := vt.ValidateMaskedContext(ctx, v, mask.Sub("MapMsgKeyValue")); err != nil {
+					return vt.NestedKey("MapMsgKeyValue", k, err)
+				}
+			}
 		}
 	}
-	for _, v := range m.GetMapIn() {
-		_src3 := []string{string("123"), string("456"), string("789")}
+	if mask.Has("MapIn") {
+		for _, v := range m.GetMapIn() {
+			_src3 := []string{string("123"), string("456"), string("789")}
 
-		for _, src := range _src3 {
-			if v == src {
-				return fmt.Errorf("field v not_in rule failed, current value: %v", v)
+			for _, src := range _src3 {
+				if v == src {
+					return &vt.Violation{
+						ID:         "vt.string.not_in",
+						Field:      "MapIn",
+						Name:       "MapIn",
+						Value:      v,
+						Constraint: "[123, 456, 789]",
+					}
+				}
 			}
 		}
 	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
+	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *MapValidate) Normalize() {
+	if m == nil {
+		return
+	}
+	for _, v := range m.MapNoSparse {
+		vt.Normalize(v)
+	}
+	for _, v := range m.MapMsgKeyValue {
+		vt.Normalize(v)
+	}
+}
+
 func (m *FuncValidate) Validate() error {
-	_src2 := time.Now().UnixNano()
-	_src1 := _src2 + int64(122)
-	_src := _src1 + int64(1000)
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *FuncValidate) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *FuncValidate) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *FuncValidate) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
 
-	if m.GetFunc1() <= int64(_src) {
-		return fmt.Errorf("field Func1 gt rule failed, current value: %v", m.GetFunc1())
+func (m *FuncValidate) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("Func1") {
+		_src2 := vt.Now(ctx).UnixNano()
+		_src1 := _src2 + int64(122)
+		_src := _src1 + int64(1000)
+
+		if m.GetFunc1() <= int64(_src) {
+			return &vt.Violation{
+				ID:         "vt.int.gt",
+				Field:      "Func1",
+				Name:       "Func1",
+				Value:      m.GetFunc1(),
+				Constraint: "@add(@add(@now_unix_nano(), 122), 1000)",
+			}
+		}
+	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
 
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *FuncValidate) Normalize() {
+	if m == nil {
+		return
+	}
+}
+
 func (m *Example) Validate() error {
-	_src := m.GetMaxLength()
-	if fl := os.Getenv("FIXED_LENGTH"); fl != "" {
-		if l, err := strconv.ParseInt(fl, 10, 0); err == nil {
-			_src += l
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *Example) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *Example) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *Example) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *Example) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Has("Msg") {
+		_src := m.GetMaxLength()
+		if fl := os.Getenv("FIXED_LENGTH"); fl != "" {
+			if l, err := strconv.ParseInt(fl, 10, 0); err == nil {
+				_src += l
+			}
 		}
-	}
 
-	if len(m.GetMsg()) > int(_src) {
-		return fmt.Errorf("field Msg max_len rule failed, current value: %d", len(m.GetMsg()))
+		if len(m.GetMsg()) > int(_src) {
+			return &vt.Violation{
+				ID:         "vt.string.max_size",
+				Field:      "Msg",
+				Name:       "Msg",
+				Value:      len(m.GetMsg()),
+				Constraint: "@fix_length($MaxLength)",
+			}
+		}
+	}
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
 	}
 	return nil
 }
+
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *Example) Normalize() {
+	if m == nil {
+		return
+	}
+}
@@ -1,13 +1,15 @@
 // Code generated by protoc-gen-validator. DO NOT EDIT.
 // versions:
-// 	protoc-gen-validator v0.1.0
+// 	protoc-gen-validator v0.1.2
 // source: api.proto
 
 package api
 
 import (
 	bytes "bytes"
+	context "context"
 	fmt "fmt"
+	vt "github.com/cloudwego/protoc-gen-validator/vt"
 	reflect "reflect"
 	regexp "regexp"
 	strings "strings"
@@ -25,5 +27,41 @@ var (
 )
 
 func (m *FieldRules) Validate() error {
+	return m.validateMask(context.Background(), nil)
+}
+
+// ValidateWithContext is Validate passing ctx to the nested messages, the custom
+// functions, the hooks and the hand-written checks.
+func (m *FieldRules) ValidateWithContext(ctx context.Context) error {
+	return m.validateMask(ctx, nil)
+}
+
+// ValidateFields validates the fields in the paths and their nested messages, the
+// message level rules are checked if the fields they refer to are in the paths.
+func (m *FieldRules) ValidateFields(paths ...string) error {
+	return m.validateMask(context.Background(), vt.NewFieldMask(paths...))
+}
+
+// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.
+func (m *FieldRules) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	return m.validateMask(ctx, vt.NewFieldMask(paths...))
+}
+
+func (m *FieldRules) validateMask(ctx context.Context, mask *vt.FieldMask) error {
+	if mask.Forced() {
+		return vt.ValidateExtra(ctx, m)
+	}
 	return nil
 }
+
+// Normalize fills the defaults and transforms the strings of the message and its
+// nested messages by the default, trim, lower and upper options, it's meant to be
+// called before Validate.
+func (m *FieldRules) Normalize() {
+	if m == nil {
+		return
+	}
+	vt.Normalize(m.Key)
+	vt.Normalize(m.Value)
+	vt.Normalize(m.Elem)
+}
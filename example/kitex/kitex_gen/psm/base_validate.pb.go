@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-validator. DO NOT EDIT.
 // versions:
-// 	protoc-gen-validator v0.1.0
+// 	protoc-gen-validator v0.1.2
 // source: base.proto
 
 package psm
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-validator. DO NOT EDIT.
 // versions:
-// 	protoc-gen-validator v0.1.1
+// 	protoc-gen-validator v0.1.2
 // source: base.proto
 
 package psm
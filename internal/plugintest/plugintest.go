@@ -0,0 +1,125 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plugintest runs the generators on the descriptor sets of the fixtures in
+// testdata, and compares the generated files with the golden files.
+package plugintest
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// New returns a plugin generating the files of the FileDescriptorSet at path, which
+// is built by protoc --include_imports --include_source_info. The parameters of
+// the generators are left in the request and ignored by protogen.
+func New(t testing.TB, path, param string, files ...string) *protogen.Plugin {
+	t.Helper()
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(b, set); err != nil {
+		t.Fatalf("unmarshal %s failed: %v", path, err)
+	}
+	gen, err := protogen.Options{
+		ParamFunc: func(name, value string) error { return nil },
+	}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: files,
+		Parameter:      proto.String(param),
+		ProtoFile:      set.GetFile(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+// Golden compares the files generated by gen with the golden files in dir, which
+// are named by the base names of the generated files with a .golden suffix. The
+// golden files are rewritten by go test -update.
+func Golden(t *testing.T, gen *protogen.Plugin, dir string) {
+	t.Helper()
+	resp := gen.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	if len(resp.File) == 0 {
+		t.Fatal("no file is generated")
+	}
+	for _, f := range resp.File {
+		GoldenFile(t, filepath.Join(dir, filepath.Base(f.GetName())+".golden"), f.GetContent())
+	}
+}
+
+// GoldenFile compares got with the content of the golden file, which is rewritten
+// by go test -update.
+func GoldenFile(t *testing.T, golden, got string) {
+	t.Helper()
+	if *update {
+		if err := ioutil.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Errorf("%v, run go test -update to create it", err)
+		return
+	}
+	if line, ok := diff(got, string(want)); !ok {
+		t.Errorf("%s differs at line %d, run go test -update to update it:\n%s", golden, line+1, excerpt(got, line))
+	}
+}
+
+// diff returns the index of the first different line of got and want.
+func diff(got, want string) (int, bool) {
+	if got == want {
+		return 0, true
+	}
+	g, w := strings.Split(got, "\n"), strings.Split(want, "\n")
+	for i := range g {
+		if i >= len(w) || g[i] != w[i] {
+			return i, false
+		}
+	}
+	return len(g), false
+}
+
+// excerpt returns the lines of s around the line.
+func excerpt(s string, line int) string {
+	lines := strings.Split(s, "\n")
+	from, to := line-3, line+4
+	if from < 0 {
+		from = 0
+	}
+	if to > len(lines) {
+		to = len(lines)
+	}
+	if from >= to {
+		return ""
+	}
+	return strings.Join(lines[from:to], "\n")
+}
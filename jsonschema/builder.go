@@ -0,0 +1,495 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonschema
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Builder translates messages and their vt rules to JSON Schemas. Referenced
+// messages are collected as definitions.
+type Builder struct {
+	// RefPrefix is prepended to the full name of a message to build its $ref,
+	// e.g. "#/$defs/" or "#/components/schemas/".
+	RefPrefix string
+	// FieldName returns the property name of a field.
+	FieldName func(f *protogen.Field) string
+	// Severity is the default severity of the rules, like the severity parameter
+	// of the plugin. The warn rules only go to the vendor extension.
+	Severity string
+
+	parser *parser.Parser
+	defs   *Schemas
+}
+
+func NewBuilder(refPrefix string, fieldName func(f *protogen.Field) string) *Builder {
+	return &Builder{
+		RefPrefix: refPrefix,
+		FieldName: fieldName,
+		parser:    parser.NewParser(),
+		defs:      NewSchemas(),
+	}
+}
+
+// JSONName names the properties as the proto3 JSON mapping does.
+func JSONName(f *protogen.Field) string {
+	return f.Desc.JSONName()
+}
+
+// Definitions returns the schemas of the messages referenced so far.
+func (b *Builder) Definitions() *Schemas {
+	return b.defs
+}
+
+// Message returns the object schema of msg.
+func (b *Builder) Message(msg *protogen.Message) (*Schema, error) {
+	msgValidation, fieldValidations, err := b.parser.Parse(msg)
+	if err != nil {
+		return nil, err
+	}
+	s := &Schema{
+		Type:        "object",
+		Title:       string(msg.Desc.Name()),
+		Description: Comment(msg.Comments.Leading),
+		Properties:  NewSchemas(),
+	}
+	for _, f := range msg.Fields {
+		fs, required, err := b.Field(f, fieldValidations[f.Desc.Number()])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Desc.Name(), err)
+		}
+		name := b.FieldName(f)
+		s.Properties.Set(name, fs)
+		if required {
+			s.Required = append(s.Required, name)
+		}
+	}
+	for _, rule := range msgValidation.Rules {
+		if rule.IsWarn(b.Severity) {
+			s.SetWarnRule(parser.KeyString[rule.Key], vendorRule(rule))
+			continue
+		}
+		switch rule.Key {
+		case parser.Assert:
+			s.SetVendorRule(parser.KeyString[rule.Key], rule.Specified.String())
+		case parser.OneofRequired:
+			var anyOf []*Schema
+			for _, oneof := range msg.Oneofs {
+				if string(oneof.Desc.Name()) != rule.Specified.TypedValue.Binary {
+					continue
+				}
+				for _, f := range oneof.Fields {
+					anyOf = append(anyOf, &Schema{Required: []string{b.FieldName(f)}})
+				}
+			}
+			s.AllOf = append(s.AllOf, &Schema{AnyOf: anyOf})
+		default:
+			return nil, fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+	}
+	return s, nil
+}
+
+// Ref returns a reference to the schema of msg, and adds the schema to the
+// definitions if it's not there.
+func (b *Builder) Ref(msg *protogen.Message) (*Schema, error) {
+	name := string(msg.Desc.FullName())
+	if _, ok := b.defs.Get(name); !ok {
+		// occupy the name first, messages may refer to themselves
+		b.defs.Set(name, nil)
+		s, err := b.Message(msg)
+		if err != nil {
+			return nil, err
+		}
+		b.defs.Set(name, s)
+	}
+	return &Schema{Ref: b.RefPrefix + name}, nil
+}
+
+// Field returns the schema of f with its rules applied, and whether f is required.
+func (b *Builder) Field(f *protogen.Field, v *parser.Validation) (s *Schema, required bool, err error) {
+	switch {
+	case f.Desc.IsMap():
+		val, err := b.typeSchema(f.Message.Fields[1])
+		if err != nil {
+			return nil, false, err
+		}
+		s = &Schema{Type: "object", AdditionalProperties: val}
+	case f.Desc.IsList():
+		item, err := b.typeSchema(f)
+		if err != nil {
+			return nil, false, err
+		}
+		s = &Schema{Type: "array", Items: item}
+	default:
+		s, err = b.typeSchema(f)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	s.Description = Comment(f.Comments.Leading)
+	if v == nil {
+		return s, false, nil
+	}
+	if v.When != nil {
+		// the conditional rules are not expressed by the schema, they go to the
+		// vendor extension along with the condition
+		s.SetVendorRule("when", v.When.String())
+		for _, rule := range v.Rules {
+			if rule.Inner == nil && rule.IsWarn(b.Severity) {
+				s.SetWarnRule(parser.KeyString[rule.Key], vendorRule(rule))
+			} else {
+				s.SetVendorRule(parser.KeyString[rule.Key], vendorRule(rule))
+			}
+		}
+		return s, false, nil
+	}
+	required, err = b.applyRules(s, f, v)
+	return s, required, err
+}
+
+func (b *Builder) typeSchema(f *protogen.Field) (*Schema, error) {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "uint32"}, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return &Schema{Type: "integer", Format: "uint64"}, nil
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}, nil
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case protoreflect.StringKind:
+		return &Schema{Type: "string"}, nil
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", ContentEncoding: "base64"}, nil
+	case protoreflect.EnumKind:
+		return &Schema{Type: "string"}, nil
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if s, ok := wellKnownSchema(f.Message.Desc.FullName()); ok {
+			return s, nil
+		}
+		return b.Ref(f.Message)
+	default:
+		return nil, fmt.Errorf("type %s not recognized", f.Desc.Kind())
+	}
+}
+
+// wellKnownSchema maps the well-known types to their JSON representations.
+func wellKnownSchema(name protoreflect.FullName) (*Schema, bool) {
+	switch name {
+	case "google.protobuf.Timestamp":
+		return &Schema{Type: "string", Format: "date-time"}, true
+	case "google.protobuf.Duration":
+		return &Schema{Type: "string", Pattern: `^-?[0-9]+(\.[0-9]+)?s$`}, true
+	case "google.protobuf.FieldMask":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.Struct", "google.protobuf.Empty", "google.protobuf.Any":
+		return &Schema{Type: "object"}, true
+	case "google.protobuf.ListValue":
+		return &Schema{Type: "array"}, true
+	case "google.protobuf.Value":
+		return &Schema{}, true
+	case "google.protobuf.BoolValue":
+		return &Schema{Type: "boolean"}, true
+	case "google.protobuf.Int32Value":
+		return &Schema{Type: "integer", Format: "int32"}, true
+	case "google.protobuf.UInt32Value":
+		return &Schema{Type: "integer", Format: "uint32"}, true
+	case "google.protobuf.Int64Value":
+		return &Schema{Type: "integer", Format: "int64"}, true
+	case "google.protobuf.UInt64Value":
+		return &Schema{Type: "integer", Format: "uint64"}, true
+	case "google.protobuf.FloatValue":
+		return &Schema{Type: "number", Format: "float"}, true
+	case "google.protobuf.DoubleValue":
+		return &Schema{Type: "number", Format: "double"}, true
+	case "google.protobuf.StringValue":
+		return &Schema{Type: "string"}, true
+	case "google.protobuf.BytesValue":
+		return &Schema{Type: "string", ContentEncoding: "base64"}, true
+	}
+	return nil, false
+}
+
+// applyRules maps the rules of v onto the keywords of s, the rules that can not be
+// expressed go to the vendor extension.
+func (b *Builder) applyRules(s *Schema, f *protogen.Field, v *parser.Validation) (required bool, err error) {
+	for _, rule := range v.Rules {
+		key := parser.KeyString[rule.Key]
+		if rule.Inner == nil && rule.IsWarn(b.Severity) {
+			// the values violating the warn rules are still valid
+			s.SetWarnRule(key, vendorRule(rule))
+			continue
+		}
+		switch v.ValidationType {
+		case parser.ListValidation:
+			switch rule.Key {
+			case parser.MinSize:
+				s.MinItems = setInt(s, key, rule.Specified)
+			case parser.MaxSize:
+				s.MaxItems = setInt(s, key, rule.Specified)
+			case parser.Elem:
+				if _, err := b.applyRules(s.Items, f, rule.Inner); err != nil {
+					return false, err
+				}
+			default:
+				return false, fmt.Errorf("unknown list annotation %s", key)
+			}
+		case parser.MapValidation:
+			switch rule.Key {
+			case parser.MinSize:
+				s.MinProperties = setInt(s, key, rule.Specified)
+			case parser.MaxSize:
+				s.MaxProperties = setInt(s, key, rule.Specified)
+			case parser.NoSparse:
+				s.SetVendorRule(key, rule.Specified.String())
+			case parser.MapKey:
+				keyField := f.Message.Fields[0]
+				if keyField.Desc.Kind() != protoreflect.StringKind {
+					// keys are always strings in JSON, the rules on other types can't be expressed
+					s.SetVendorRule(key, vendorRules(rule.Inner))
+					continue
+				}
+				s.PropertyNames = &Schema{}
+				if _, err := b.applyRules(s.PropertyNames, keyField, rule.Inner); err != nil {
+					return false, err
+				}
+			case parser.MapValue:
+				if _, err := b.applyRules(s.AdditionalProperties, f.Message.Fields[1], rule.Inner); err != nil {
+					return false, err
+				}
+			default:
+				return false, fmt.Errorf("unknown map annotation %s", key)
+			}
+		default:
+			if rule.Key == parser.NotNil {
+				required = required || rule.Specified.TypedValue.Bool
+				continue
+			}
+			if err := applyScalarRule(s, f, v, rule); err != nil {
+				return false, err
+			}
+		}
+	}
+	return required, nil
+}
+
+func applyScalarRule(s *Schema, f *protogen.Field, v *parser.Validation, rule *parser.Rule) error {
+	key := parser.KeyString[rule.Key]
+	vt := v.ValidationType
+	isBytes := f.Desc.Kind() == protoreflect.BytesKind
+	switch rule.Key {
+	case parser.In, parser.NotIn:
+		var vals []interface{}
+		for _, val := range rule.Range {
+			if vt == parser.EnumValidation {
+				// enum values are written as Type.VALUE, JSON uses the value names
+				divId := strings.Split(val.TypedValue.Binary, ".")
+				vals = append(vals, divId[len(divId)-1])
+				continue
+			}
+			c, ok := constValue(val, isBytes)
+			if !ok {
+				s.SetVendorRule(key, rangeStrings(rule.Range))
+				return nil
+			}
+			vals = append(vals, c)
+		}
+		if rule.Key == parser.In {
+			s.Enum = vals
+		} else {
+			s.addNot(&Schema{Enum: vals})
+		}
+		return nil
+	case parser.Skip:
+		if rule.Specified.TypedValue.Bool {
+			s.SetVendorRule(key, rule.Specified.String())
+		}
+		return nil
+	case parser.Finite:
+		// the numbers of JSON are always finite
+		return nil
+	}
+
+	c, ok := constValue(rule.Specified, isBytes)
+	if !ok {
+		s.SetVendorRule(key, rule.Specified.String())
+		return nil
+	}
+	switch vt {
+	case parser.NumericValidation:
+		switch rule.Key {
+		case parser.Const:
+			if v.Epsilon > 0 && rule.Specified.ValueType == parser.DoubleValue {
+				s.Minimum = rule.Specified.TypedValue.Double - v.Epsilon
+				s.Maximum = rule.Specified.TypedValue.Double + v.Epsilon
+				return nil
+			}
+			s.Const = c
+		case parser.MultipleOf:
+			s.MultipleOf = c
+		case parser.LessThan:
+			s.ExclusiveMaximum = c
+		case parser.LessEqual:
+			s.Maximum = c
+		case parser.GreatThan:
+			s.ExclusiveMinimum = c
+		case parser.GreatEqual:
+			s.Minimum = c
+		default:
+			return fmt.Errorf("unknown numeric annotation %s", key)
+		}
+	case parser.BoolValidation:
+		if rule.Key != parser.Const {
+			return fmt.Errorf("unknown bool annotation %s", key)
+		}
+		s.Const = c
+	case parser.EnumValidation:
+		switch rule.Key {
+		case parser.Const:
+			divId := strings.Split(rule.Specified.TypedValue.Binary, ".")
+			s.Const = divId[len(divId)-1]
+		case parser.DefinedOnly:
+			if !rule.Specified.TypedValue.Bool {
+				return nil
+			}
+			var names []interface{}
+			values := f.Desc.Enum().Values()
+			for i := 0; i < values.Len(); i++ {
+				names = append(names, string(values.Get(i).Name()))
+			}
+			s.Enum = names
+		default:
+			return fmt.Errorf("unknown enum annotation %s", key)
+		}
+	case parser.BinaryValidation:
+		if isBytes && rule.Key != parser.Const {
+			// the JSON value of bytes is base64 encoded, only const can be mapped
+			s.SetVendorRule(key, rule.Specified.String())
+			return nil
+		}
+		switch rule.Key {
+		case parser.Const:
+			s.Const = c
+		case parser.MinSize:
+			s.MinLength = setInt(s, key, rule.Specified)
+		case parser.MaxSize:
+			s.MaxLength = setInt(s, key, rule.Specified)
+		case parser.Pattern:
+			s.AddPattern(rule.Specified.TypedValue.Binary)
+		case parser.Prefix:
+			s.AddPattern("^" + regexp.QuoteMeta(rule.Specified.TypedValue.Binary))
+		case parser.Suffix:
+			s.AddPattern(regexp.QuoteMeta(rule.Specified.TypedValue.Binary) + "$")
+		case parser.Contains:
+			s.AddPattern(regexp.QuoteMeta(rule.Specified.TypedValue.Binary))
+		case parser.NotContains:
+			s.addNot(&Schema{Pattern: regexp.QuoteMeta(rule.Specified.TypedValue.Binary)})
+		default:
+			return fmt.Errorf("unknown binary annotation %s", key)
+		}
+	case parser.StructLikeFieldValidation:
+		return fmt.Errorf("unknown struct like annotation %s", key)
+	}
+	return nil
+}
+
+func (s *Schema) addNot(not *Schema) {
+	if s.Not == nil {
+		s.Not = not
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Not: not})
+}
+
+// constValue returns the JSON value of a constant, or false for field references
+// and functions.
+func constValue(v *parser.ValidationValue, isBytes bool) (interface{}, bool) {
+	switch v.ValueType {
+	case parser.IntValue:
+		return v.TypedValue.Int, true
+	case parser.DoubleValue:
+		return v.TypedValue.Double, true
+	case parser.BoolValue:
+		return v.TypedValue.Bool, true
+	case parser.BinaryValue:
+		if isBytes {
+			return base64.StdEncoding.EncodeToString([]byte(v.TypedValue.Binary)), true
+		}
+		return v.TypedValue.Binary, true
+	default:
+		return nil, false
+	}
+}
+
+func setInt(s *Schema, key string, v *parser.ValidationValue) *int64 {
+	if v.ValueType != parser.IntValue {
+		s.SetVendorRule(key, v.String())
+		return nil
+	}
+	n := v.TypedValue.Int
+	return &n
+}
+
+func rangeStrings(vals []*parser.ValidationValue) []string {
+	ret := make([]string, 0, len(vals))
+	for _, val := range vals {
+		ret = append(ret, val.String())
+	}
+	return ret
+}
+
+// vendorRules renders all the rules of v for the vendor extension.
+func vendorRules(v *parser.Validation) map[string]interface{} {
+	ret := make(map[string]interface{}, len(v.Rules))
+	for _, rule := range v.Rules {
+		ret[parser.KeyString[rule.Key]] = vendorRule(rule)
+	}
+	return ret
+}
+
+// vendorRule renders the value of a rule for the vendor extension.
+func vendorRule(rule *parser.Rule) interface{} {
+	switch {
+	case rule.Inner != nil:
+		return vendorRules(rule.Inner)
+	case rule.Range != nil:
+		return rangeStrings(rule.Range)
+	default:
+		return rule.Specified.String()
+	}
+}
+
+// Comment returns a leading comment as a description, with the indents trimmed.
+func Comment(c protogen.Comments) string {
+	lines := strings.Split(strings.TrimSpace(string(c)), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Generator writes a <full name>.schema.json for every message of a proto file.
+type Generator struct {
+	*protogen.Plugin
+	PbFile *protogen.File
+
+	severity string
+}
+
+func NewGenerator(plu *protogen.Plugin, file *protogen.File) *Generator {
+	return &Generator{
+		Plugin: plu,
+		PbFile: file,
+	}
+}
+
+// SetSeverity sets the severity of the rules without one, error or warn, like the
+// severity parameter of the plugin. The warn rules only go to the vendor extension.
+func (g *Generator) SetSeverity(severity string) {
+	g.severity = severity
+}
+
+func (g *Generator) Generate() error {
+	return g.generateMessages(g.PbFile.Messages)
+}
+
+func (g *Generator) generateMessages(msgs []*protogen.Message) error {
+	for _, msg := range msgs {
+		if msg.Desc.IsMapEntry() {
+			continue
+		}
+		name := string(msg.Desc.FullName()) + ".schema.json"
+		b := NewBuilder("#/$defs/", JSONName)
+		b.Severity = g.severity
+		s, err := b.Message(msg)
+		if err != nil {
+			return fmt.Errorf("generate json schema for %s failed: %w", msg.Desc.FullName(), err)
+		}
+		s.Schema = Draft
+		s.ID = name
+		if b.Definitions().Len() > 0 {
+			s.Defs = b.Definitions()
+		}
+		data, err := Marshal(s)
+		if err != nil {
+			return err
+		}
+		genFile := g.NewGeneratedFile(path.Join(path.Dir(g.PbFile.GeneratedFilenamePrefix), name), "")
+		genFile.Write(data)
+
+		if err := g.generateMessages(msg.Messages); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Marshal encodes v as indented JSON without escaping HTML characters, which are
+// common in patterns.
+func Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
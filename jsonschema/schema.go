@@ -0,0 +1,163 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// warnKey is the key of the warn rules in the vendor extension.
+const warnKey = "warn"
+
+// Schema is a JSON Schema (draft 2020-12) object, which is also the Schema Object
+// of OpenAPI 3.1. The fields are declared in the order they are marshaled.
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+
+	Type            interface{} `json:"type,omitempty"`
+	Format          string      `json:"format,omitempty"`
+	ContentEncoding string      `json:"contentEncoding,omitempty"`
+
+	Const            interface{}   `json:"const,omitempty"`
+	Enum             []interface{} `json:"enum,omitempty"`
+	Minimum          interface{}   `json:"minimum,omitempty"`
+	ExclusiveMinimum interface{}   `json:"exclusiveMinimum,omitempty"`
+	Maximum          interface{}   `json:"maximum,omitempty"`
+	ExclusiveMaximum interface{}   `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       interface{}   `json:"multipleOf,omitempty"`
+	MinLength        *int64        `json:"minLength,omitempty"`
+	MaxLength        *int64        `json:"maxLength,omitempty"`
+	Pattern          string        `json:"pattern,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MinItems *int64  `json:"minItems,omitempty"`
+	MaxItems *int64  `json:"maxItems,omitempty"`
+
+	Properties           *Schemas `json:"properties,omitempty"`
+	Required             []string `json:"required,omitempty"`
+	PropertyNames        *Schema  `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema  `json:"additionalProperties,omitempty"`
+	MinProperties        *int64   `json:"minProperties,omitempty"`
+	MaxProperties        *int64   `json:"maxProperties,omitempty"`
+
+	AllOf []*Schema `json:"allOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	Not   *Schema   `json:"not,omitempty"`
+
+	Defs *Schemas `json:"$defs,omitempty"`
+
+	// VT is the vendor extension holding the vt rules that can not be expressed
+	// by JSON Schema, such as function values and field references.
+	VT map[string]interface{} `json:"x-vt,omitempty"`
+}
+
+// SetWarnRule records a rule of the warn severity in the warn map of the vendor
+// extension, the values violating it are reported by Validate but not rejected.
+func (s *Schema) SetWarnRule(key string, value interface{}) {
+	warn, ok := s.VT[warnKey].(map[string]interface{})
+	if !ok {
+		warn = make(map[string]interface{})
+		s.SetVendorRule(warnKey, warn)
+	}
+	warn[key] = value
+}
+
+// SetVendorRule records a rule that can not be expressed by JSON Schema.
+func (s *Schema) SetVendorRule(key string, value interface{}) {
+	if s.VT == nil {
+		s.VT = make(map[string]interface{})
+	}
+	s.VT[key] = value
+}
+
+// AddPattern sets the pattern keyword, further patterns go to allOf since a
+// schema can only have one pattern.
+func (s *Schema) AddPattern(pattern string) {
+	if s.Pattern == "" {
+		s.Pattern = pattern
+		return
+	}
+	s.AllOf = append(s.AllOf, &Schema{Pattern: pattern})
+}
+
+// Schemas is an ordered map of schemas, used for properties and definitions.
+type Schemas struct {
+	keys   []string
+	values map[string]*Schema
+}
+
+func NewSchemas() *Schemas {
+	return &Schemas{values: make(map[string]*Schema)}
+}
+
+func (s *Schemas) Set(key string, value *Schema) {
+	if _, ok := s.values[key]; !ok {
+		s.keys = append(s.keys, key)
+	}
+	s.values[key] = value
+}
+
+func (s *Schemas) Get(key string) (*Schema, bool) {
+	v, ok := s.values[key]
+	return v, ok
+}
+
+func (s *Schemas) Len() int {
+	return len(s.keys)
+}
+
+func (s *Schemas) Keys() []string {
+	return s.keys
+}
+
+func (s *Schemas) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, k := range s.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := marshalCompact(k)
+		if err != nil {
+			return nil, err
+		}
+		val, err := marshalCompact(s.values[k])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func marshalCompact(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
@@ -22,7 +22,11 @@ import (
 	"strings"
 
 	"github.com/cloudwego/protoc-gen-validator/adopt"
+	"github.com/cloudwego/protoc-gen-validator/doc"
+	"github.com/cloudwego/protoc-gen-validator/jsonschema"
+	"github.com/cloudwego/protoc-gen-validator/openapi"
 	"github.com/cloudwego/protoc-gen-validator/validator"
+	"github.com/cloudwego/protoc-gen-validator/zod"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/types/pluginpb"
 )
@@ -32,16 +36,30 @@ func main() {
 		fmt.Fprintf(os.Stdout, "%v %v\n", filepath.Base(os.Args[0]), validator.Version)
 		os.Exit(0)
 	}
+	if len(os.Args) >= 2 && os.Args[1] == "check" {
+		os.Exit(runCheck(os.Args[2:]))
+	}
+	if len(os.Args) >= 2 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
+	}
 
 	var (
-		flags   flag.FlagSet
-		recurse = flags.Bool("recurse", false, "recurse generate")
-		_       = flags.String("func", "", "customize function")
-		isHz    = flags.Bool("hz", false, "adopt hz")
-		isKitex = flags.Bool("kitex", false, "adopt kitex")
-		_       = flags.String("out_dir", ".", "output dir")
-		_       = flags.String("go_mod", "", "go module")
-		_       = flags.String("model_dir", "biz/model", "model dir")
+		flags        flag.FlagSet
+		recurse      = flags.Bool("recurse", false, "recurse generate")
+		_            = flags.String("func", "", "customize function")
+		isHz         = flags.Bool("hz", false, "adopt hz")
+		isKitex      = flags.Bool("kitex", false, "adopt kitex")
+		isJSONSchema = flags.Bool("jsonschema", false, "generate json schema instead of go code")
+		isZod        = flags.Bool("zod", false, "generate typescript zod schemas instead of go code")
+		docFormat    = flags.String("doc", "", "generate constraint documents in md or html instead of go code")
+		isOpenAPI    = flags.Bool("openapi", false, "generate openapi documents for hz routes")
+		isGRPC       = flags.Bool("grpc", false, "generate grpc-go interceptors calling Validate")
+		severity     = flags.String("severity", "error", "default severity of the rules, error or warn")
+		_            = flags.Bool("hooks", false, "call the hooks of vt on the failed rules")
+		_            = flags.String("out_dir", ".", "output dir")
+		_            = flags.String("go_mod", "", "go module")
+		_            = flags.String("GoMod", "", "go module for kitex")
+		_            = flags.String("model_dir", "biz/model", "model dir")
 	)
 
 	protogen.Options{
@@ -52,6 +70,9 @@ func main() {
 			if err != nil {
 				return err
 			}
+			if *isOpenAPI {
+				return generateOpenAPI(gen, *severity)
+			}
 			return nil
 		}
 
@@ -64,29 +85,88 @@ func main() {
 		}
 
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		var docGen *doc.Generator
+		if *docFormat != "" {
+			var err error
+			docGen, err = doc.NewGenerator(gen, *docFormat)
+			if err != nil {
+				return err
+			}
+			docGen.SetSeverity(*severity)
+		}
+		// the grpc interceptors are generated once for a package with services, they
+		// use the dispatchers of all the services of the package
+		interceptors := map[protogen.GoImportPath]bool{}
+		services := map[protogen.GoImportPath][]*protogen.Service{}
+		var files []*protogen.File
 		for _, f := range gen.Files {
 			if strings.HasPrefix(f.Proto.GetPackage(), "google.protobuf") {
 				continue
 			}
+			if !*recurse && !f.Generate {
+				continue
+			}
+			files = append(files, f)
+			services[f.GoImportPath] = append(services[f.GoImportPath], f.Services...)
+		}
+		for _, f := range files {
+			if *isJSONSchema {
+				g := jsonschema.NewGenerator(gen, f)
+				g.SetSeverity(*severity)
+				if err := g.Generate(); err != nil {
+					return err
+				}
+				continue
+			}
+			if docGen != nil {
+				docGen.Add(f)
+				continue
+			}
+			if *isZod {
+				g := zod.NewGenerator(gen, f)
+				g.SetSeverity(*severity)
+				if err := g.Generate(); err != nil {
+					return err
+				}
+				continue
+			}
 			g, err := validator.NewGenerator(gen, f)
 			if err != nil {
 				return err
 			}
-			if *recurse {
-				err = g.Generate()
-				if err != nil {
+			err = g.Generate()
+			if err != nil {
+				return err
+			}
+			if *isGRPC && len(f.Services) > 0 && !interceptors[f.GoImportPath] {
+				interceptors[f.GoImportPath] = true
+				if err = g.GenerateGRPCInterceptors(services[f.GoImportPath]); err != nil {
 					return err
 				}
-			} else {
-				if f.Generate {
-					err = g.Generate()
-					if err != nil {
-						return err
-					}
-				}
 			}
 		}
+		if docGen != nil {
+			return docGen.Generate()
+		}
+		if *isOpenAPI {
+			return generateOpenAPI(gen, *severity)
+		}
 
 		return nil
 	})
 }
+
+// generateOpenAPI writes the openapi documents for the files declaring hz routes.
+func generateOpenAPI(gen *protogen.Plugin, severity string) error {
+	for _, f := range gen.Files {
+		if !f.Generate || len(f.Services) == 0 {
+			continue
+		}
+		g := openapi.NewGenerator(gen, f)
+		g.SetSeverity(severity)
+		if err := g.Generate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
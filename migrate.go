@@ -0,0 +1,85 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudwego/protoc-gen-validator/migrate"
+)
+
+// runMigrate rewrites the protoc-gen-validate and vd options of .proto files to
+// vt options in place, it returns the exit code: 0 for success, 1 if some rules
+// are not migrated and 2 for other errors.
+func runMigrate(args []string) int {
+	var (
+		flags     = flag.NewFlagSet("migrate", flag.ExitOnError)
+		descPath  = flags.String("descriptor_set", "", "FileDescriptorSet generated by 'protoc --include_imports --include_source_info --descriptor_set_out'")
+		protoPath = flags.String("proto_path", ".", "directory the file names in the descriptor set are relative to")
+		apiImport = flags.String("api_import", "api.proto", "import path of api.proto added to the migrated files")
+		dryRun    = flags.Bool("dry_run", false, "list the rules not migrated without writing the files")
+	)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s migrate -descriptor_set <file> [-proto_path <dir>] [-api_import <path>] [-dry_run] <file.proto>...\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if *descPath == "" || flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	set, err := readDescriptorSet(*descPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	m, err := migrate.NewMigrator(set)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+	m.APIImport = *apiImport
+	code := 0
+	for _, name := range flags.Args() {
+		path := filepath.Join(*protoPath, name)
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "read %s failed: %v\n", path, err)
+			return 2
+		}
+		out, reports, err := m.Migrate(filepath.ToSlash(name), src)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 2
+		}
+		for _, r := range reports {
+			fmt.Fprintln(os.Stdout, r)
+			code = 1
+		}
+		if *dryRun || string(out) == string(src) {
+			continue
+		}
+		if err = ioutil.WriteFile(path, out, 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "write %s failed: %v\n", path, err)
+			return 2
+		}
+	}
+	return code
+}
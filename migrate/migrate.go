@@ -0,0 +1,599 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package migrate rewrites the protoc-gen-validate and vd options in .proto
+// sources to the equivalent vt options.
+package migrate
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"github.com/cloudwego/protoc-gen-validator/parser/pgv"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// the field numbers of descriptor.proto used in the source paths
+const (
+	fieldDefaultValue = 7
+	fieldOptions      = 8
+	fieldJSONName     = 10
+	messageOptions    = 7
+	oneofOptions      = 2
+	fileDependency    = 3
+	filePackage       = 2
+)
+
+// Report is a rule that is not migrated.
+type Report struct {
+	Pos    string // file:line:column of the option
+	Target string // full name of the field, oneof or message
+	Reason string
+}
+
+func (r *Report) String() string {
+	return r.Pos + ": " + r.Target + ": " + r.Reason
+}
+
+// Migrator rewrites the sources of the files in a FileDescriptorSet.
+type Migrator struct {
+	plugin *protogen.Plugin
+	// APIImport is the import path of api.proto added to the migrated files.
+	APIImport string
+}
+
+// NewMigrator builds a Migrator from a FileDescriptorSet, which must contain all
+// the imported files and the source info (protoc --include_imports --include_source_info).
+func NewMigrator(set *descriptorpb.FileDescriptorSet) (*Migrator, error) {
+	// protogen requires a go import path for every file, which is not used here
+	var params []string
+	for _, f := range set.GetFile() {
+		if f.GetOptions().GetGoPackage() == "" {
+			params = append(params, fmt.Sprintf("M%s=migrate/%s", f.GetName(), strings.TrimSuffix(f.GetName(), ".proto")))
+		}
+	}
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		ProtoFile: set.GetFile(),
+		Parameter: proto.String(strings.Join(params, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("load descriptor set failed: %w", err)
+	}
+	return &Migrator{plugin: gen, APIImport: "api.proto"}, nil
+}
+
+// Migrate rewrites src, the source of the file with the given name in the
+// descriptor set. It returns the new source and the rules that are not migrated,
+// which are dropped, or kept as they are if nothing of the option is migrated.
+func (m *Migrator) Migrate(name string, src []byte) ([]byte, []*Report, error) {
+	file, ok := m.plugin.FilesByPath[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("file %s not found in descriptor set", name)
+	}
+	locs := file.Proto.GetSourceCodeInfo().GetLocation()
+	if len(locs) == 0 {
+		return nil, nil, fmt.Errorf("file %s has no source info, generate the descriptor set with --include_source_info", name)
+	}
+	fm := &fileMigrator{file: file, src: src, locs: locs}
+	fm.lines = append(fm.lines, 0)
+	for i, c := range src {
+		if c == '\n' {
+			fm.lines = append(fm.lines, i+1)
+		}
+	}
+	for _, msg := range file.Messages {
+		if err := fm.message(msg); err != nil {
+			return nil, nil, err
+		}
+	}
+	if fm.migrated {
+		fm.imports(m.APIImport)
+	}
+	out, err := fm.apply()
+	if err != nil {
+		return nil, nil, err
+	}
+	return out, fm.reports, nil
+}
+
+type edit struct {
+	start, end int
+	text       string
+}
+
+type fileMigrator struct {
+	file  *protogen.File
+	src   []byte
+	lines []int // the offsets of the lines
+	locs  []*descriptorpb.SourceCodeInfo_Location
+
+	edits    []edit
+	reports  []*Report
+	migrated bool // some vt options are added
+	pgvKept  bool // some protoc-gen-validate options are kept
+}
+
+// span is a location converted to offsets.
+type span struct {
+	start, end int
+	path       []int32
+}
+
+func (fm *fileMigrator) report(pos int, target protoreflect.FullName, format string, a ...interface{}) {
+	line := sort.Search(len(fm.lines), func(i int) bool { return fm.lines[i] > pos }) - 1
+	fm.reports = append(fm.reports, &Report{
+		Pos:    fmt.Sprintf("%s:%d:%d", fm.file.Desc.Path(), line+1, pos-fm.lines[line]+1),
+		Target: string(target),
+		Reason: fmt.Sprintf(format, a...),
+	})
+}
+
+// offset converts a line and column of the source info to an offset, tabs advance
+// the column to the next multiple of 8 as protoc does.
+func (fm *fileMigrator) offset(line, col int32) int {
+	if int(line) >= len(fm.lines) {
+		return len(fm.src)
+	}
+	off := fm.lines[line]
+	for c := int32(0); c < col && off < len(fm.src) && fm.src[off] != '\n'; off++ {
+		if fm.src[off] == '\t' {
+			c += 8 - c%8
+		} else {
+			c++
+		}
+	}
+	return off
+}
+
+func (fm *fileMigrator) toSpan(loc *descriptorpb.SourceCodeInfo_Location) span {
+	s := loc.GetSpan()
+	endLine := s[0]
+	if len(s) == 4 {
+		endLine = s[2]
+	}
+	return span{start: fm.offset(s[0], s[1]), end: fm.offset(endLine, s[len(s)-1]), path: loc.GetPath()}
+}
+
+// find returns the spans of the locations whose path has the prefix.
+func (fm *fileMigrator) find(prefix ...int32) []span {
+	var ret []span
+	for _, loc := range fm.locs {
+		if hasPrefix(loc.GetPath(), prefix) {
+			ret = append(ret, fm.toSpan(loc))
+		}
+	}
+	sort.SliceStable(ret, func(i, j int) bool { return ret[i].start < ret[j].start })
+	return ret
+}
+
+// exact returns the span of the location with the path.
+func (fm *fileMigrator) exact(path ...int32) (span, bool) {
+	for _, s := range fm.find(path...) {
+		if len(s.path) == len(path) {
+			return s, true
+		}
+	}
+	return span{}, false
+}
+
+func hasPrefix(path, prefix []int32) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func appendPath(path protoreflect.SourcePath, elems ...int32) []int32 {
+	return append(append([]int32{}, path...), elems...)
+}
+
+var vdMessage = regexp.MustCompile(`(^|;)\s*msg\s*:`)
+
+func (fm *fileMigrator) message(msg *protogen.Message) error {
+	opts := msg.Desc.Options()
+	withPGV := true
+	if proto.GetExtension(opts, pgv.E_Disabled).(bool) || proto.GetExtension(opts, pgv.E_Ignored).(bool) {
+		withPGV = false
+		if spans := fm.find(appendPath(msg.Location.Path, messageOptions)...); len(spans) > 0 {
+			fm.pgvKept = true
+			if fm.hasPGV(msg) {
+				fm.report(spans[0].start, msg.Desc.FullName(), "the protoc-gen-validate rules are disabled, they are kept")
+			}
+		}
+	} else {
+		// (validate.disabled) = false and (validate.ignored) = false
+		for _, ext := range []protoreflect.ExtensionType{pgv.E_Disabled, pgv.E_Ignored} {
+			for _, s := range fm.find(appendPath(msg.Location.Path, messageOptions, int32(ext.TypeDescriptor().Number()))...) {
+				fm.removeLine(s)
+			}
+		}
+	}
+	var asserts []string
+	for _, f := range msg.Fields {
+		fieldAsserts, err := fm.field(msg, f, withPGV)
+		if err != nil {
+			return err
+		}
+		asserts = append(asserts, fieldAsserts...)
+	}
+	if withPGV {
+		for _, oneof := range msg.Oneofs {
+			if assert := fm.oneof(oneof); assert != "" {
+				asserts = append(asserts, assert)
+			}
+		}
+	}
+	if len(asserts) > 0 {
+		fm.addAsserts(msg, asserts)
+	}
+	for _, nested := range msg.Messages {
+		if err := fm.message(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fm *fileMigrator) hasPGV(msg *protogen.Message) bool {
+	for _, f := range msg.Fields {
+		if proto.HasExtension(f.Desc.Options(), pgv.E_Rules) {
+			return true
+		}
+	}
+	for _, oneof := range msg.Oneofs {
+		if proto.HasExtension(oneof.Desc.Options(), pgv.E_Required) {
+			return true
+		}
+	}
+	return false
+}
+
+// field rewrites the options of f, and returns the asserts of the vd expression.
+func (fm *fileMigrator) field(msg *protogen.Message, f *protogen.Field, withPGV bool) ([]string, error) {
+	path := f.Location.Path
+	pgvSpans := fm.find(appendPath(path, fieldOptions, int32(pgv.E_Rules.Field))...)
+	vdSpans := fm.find(appendPath(path, fieldOptions, int32(api.E_Vd.Field))...)
+	if !withPGV {
+		pgvSpans = nil
+	}
+	if len(pgvSpans) == 0 && len(vdSpans) == 0 {
+		return nil, nil
+	}
+	name := f.Desc.FullName()
+	var annos []*parser.Annotation
+	var asserts []string
+	removed := map[int]bool{}
+	if len(pgvSpans) > 0 {
+		annos = parser.TranslatePGV(f.Desc, func(rule, reason string) {
+			fm.report(pgvSpans[0].start, name, "rule %s is dropped, %s", rule, reason)
+		})
+		for _, s := range pgvSpans {
+			removed[s.start] = true
+		}
+	}
+	if len(vdSpans) > 0 {
+		expr := proto.GetExtension(f.Desc.Options(), api.E_Vd).(string)
+		vdAnnos, assert, err := parser.CompileVD(msg, f, expr)
+		var text string
+		if err == nil && assert != nil {
+			text, err = vtExpression(assert)
+		}
+		if err != nil {
+			fm.report(vdSpans[0].start, name, "(api.vd) is kept, %v", err)
+		} else {
+			if vdMessage.MatchString(expr) {
+				fm.report(vdSpans[0].start, name, "the msg of (api.vd) is dropped")
+			}
+			annos = append(annos, vdAnnos...)
+			if text != "" {
+				asserts = append(asserts, text)
+			}
+			for _, s := range vdSpans {
+				removed[s.start] = true
+			}
+		}
+	}
+	if len(removed) == 0 {
+		return nil, nil
+	}
+
+	existing, err := parser.RulesToAnnotations(proto.GetExtension(f.Desc.Options(), api.E_Vt).(*api.FieldRules))
+	if err != nil {
+		return nil, err
+	}
+	seen := map[string]bool{}
+	for _, anno := range existing {
+		seen[anno.Key] = true
+	}
+	var options []string
+	for _, anno := range annos {
+		option := "(api.vt)." + strings.TrimPrefix(anno.Key, "vt.")
+		if seen[anno.Key] {
+			fm.report(pgvOrVD(pgvSpans, vdSpans).start, name, "rule %s = %s is dropped, it conflicts with another one", option, strings.Join(anno.Values, ", "))
+			continue
+		}
+		seen[anno.Key] = true
+		for _, val := range anno.Values {
+			options = append(options, option+" = "+protoString(val))
+		}
+	}
+	fm.rewriteOptions(path, removed, options)
+	return asserts, nil
+}
+
+func pgvOrVD(pgvSpans, vdSpans []span) span {
+	if len(pgvSpans) > 0 {
+		return pgvSpans[0]
+	}
+	return vdSpans[0]
+}
+
+// rewriteOptions replaces the removed entries in the [...] of a field with the
+// options, the other entries are kept as they are.
+func (fm *fileMigrator) rewriteOptions(path protoreflect.SourcePath, removed map[int]bool, options []string) {
+	if len(options) > 0 {
+		fm.migrated = true
+	}
+	bracket, ok := fm.exact(appendPath(path, fieldOptions)...)
+	if !ok {
+		return
+	}
+	var entries []span
+	for _, elem := range []int32{fieldOptions, fieldJSONName, fieldDefaultValue} {
+		for _, s := range fm.find(appendPath(path, elem)...) {
+			if (elem != fieldOptions || len(s.path) > len(path)+1) && bracket.start < s.start && s.end <= bracket.end {
+				entries = append(entries, s)
+			}
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].start < entries[j].start })
+	kept := -1
+	for i, e := range entries {
+		if !removed[e.start] {
+			kept = i
+			break
+		}
+	}
+	if kept == -1 && len(options) == 0 {
+		// drop the brackets with the spaces before them
+		start := bracket.start
+		for start > 0 && (fm.src[start-1] == ' ' || fm.src[start-1] == '\t') {
+			start--
+		}
+		fm.edits = append(fm.edits, edit{start: start, end: bracket.end})
+		return
+	}
+	replaced := false
+	for i, e := range entries {
+		if !removed[e.start] {
+			continue
+		}
+		switch {
+		case !replaced && len(options) > 0:
+			fm.edits = append(fm.edits, edit{start: e.start, end: e.end, text: strings.Join(options, fm.separator(e))})
+			replaced = true
+		case i < kept && len(options) == 0:
+			// the leading entries are removed up to the first kept one
+			fm.edits = append(fm.edits, edit{start: e.start, end: entries[i+1].start})
+		default:
+			fm.edits = append(fm.edits, edit{start: entries[i-1].end, end: e.end})
+		}
+	}
+}
+
+// separator returns the separator of the options replacing e, which keeps one
+// option per line if e starts a line.
+func (fm *fileMigrator) separator(e span) string {
+	lineStart := e.start
+	for lineStart > 0 && fm.src[lineStart-1] != '\n' {
+		lineStart--
+	}
+	indent := string(fm.src[lineStart:e.start])
+	if strings.TrimSpace(indent) == "" && lineStart > 0 {
+		return ",\n" + indent
+	}
+	return ", "
+}
+
+// oneof removes (validate.required) of a oneof, and returns the equivalent assert.
+func (fm *fileMigrator) oneof(oneof *protogen.Oneof) string {
+	spans := fm.find(appendPath(oneof.Location.Path, oneofOptions, int32(pgv.E_Required.Field))...)
+	if len(spans) == 0 {
+		return ""
+	}
+	fm.removeLine(spans[0])
+	if !proto.GetExtension(oneof.Desc.Options(), pgv.E_Required).(bool) {
+		return ""
+	}
+	var has []string
+	for _, f := range oneof.Fields {
+		has = append(has, "@has($"+string(f.Desc.Name())+")")
+	}
+	if len(has) == 1 {
+		return has[0]
+	}
+	return "@or(" + strings.Join(has, ", ") + ")"
+}
+
+// removeLine removes a statement, with its line if nothing else is on it.
+func (fm *fileMigrator) removeLine(s span) {
+	start, end := s.start, s.end
+	for start > 0 && (fm.src[start-1] == ' ' || fm.src[start-1] == '\t') {
+		start--
+	}
+	for end < len(fm.src) && (fm.src[end] == ' ' || fm.src[end] == '\t' || fm.src[end] == '\r') {
+		end++
+	}
+	if (start == 0 || fm.src[start-1] == '\n') && (end == len(fm.src) || fm.src[end] == '\n') {
+		if end < len(fm.src) {
+			end++
+		}
+		fm.edits = append(fm.edits, edit{start: start, end: end})
+		return
+	}
+	fm.edits = append(fm.edits, edit{start: s.start, end: s.end})
+}
+
+// addAsserts adds the asserts as the message-level rule of msg.
+func (fm *fileMigrator) addAsserts(msg *protogen.Message, asserts []string) {
+	msgSpan, ok := fm.exact(msg.Location.Path...)
+	if !ok {
+		return
+	}
+	existing := proto.GetExtension(msg.Desc.Options(), api.E_MsgVt).(*api.FieldRules).GetAssert()
+	if existing != "" {
+		for _, assert := range asserts {
+			fm.report(msgSpan.start, msg.Desc.FullName(), "assert %s is dropped, the message has an assert already", assert)
+		}
+		return
+	}
+	assert := asserts[0]
+	if len(asserts) > 1 {
+		assert = "@and(" + strings.Join(asserts, ", ") + ")"
+	}
+	brace := msgSpan.start + strings.IndexByte(string(fm.src[msgSpan.start:msgSpan.end]), '{')
+	lineStart := msgSpan.start
+	for lineStart > 0 && fm.src[lineStart-1] != '\n' {
+		lineStart--
+	}
+	indent := strings.Repeat(" ", msgSpan.start-lineStart) + "  "
+	// follow the indentation of the body
+	if rest := fm.src[brace+1:]; len(rest) > 0 && rest[0] == '\n' {
+		body := rest[1:]
+		n := 0
+		for n < len(body) && (body[n] == ' ' || body[n] == '\t') {
+			n++
+		}
+		if n < len(body) && body[n] != '\n' && body[n] != '}' {
+			indent = string(body[:n])
+		}
+	}
+	fm.edits = append(fm.edits, edit{start: brace + 1, end: brace + 1, text: "\n" + indent + "option (api.msg_vt).assert = " + protoString(assert) + ";"})
+	fm.migrated = true
+}
+
+// imports adds the import of api.proto, and removes the one of validate.proto if
+// no protoc-gen-validate option is kept.
+func (fm *fileMigrator) imports(apiImport string) {
+	hasAPI := false
+	var last, pgvImport *span
+	imports := fm.file.Desc.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		imp := imports.Get(i)
+		s, ok := fm.exact(fileDependency, int32(i))
+		if !ok {
+			continue
+		}
+		last = &s
+		switch {
+		case imp.Package() == api.E_Vt.TypeDescriptor().ParentFile().Package() && imp.Extensions().ByName("vt") != nil:
+			hasAPI = true
+		case imp.Package() == pgv.E_Rules.TypeDescriptor().ParentFile().Package() && imp.Extensions().ByName("rules") != nil:
+			if !fm.pgvKept {
+				pgvImport = &s
+			}
+		}
+	}
+	stmt := "import " + protoString(apiImport) + ";"
+	switch {
+	case pgvImport != nil && !hasAPI:
+		fm.edits = append(fm.edits, edit{start: pgvImport.start, end: pgvImport.end, text: stmt})
+		return
+	case pgvImport != nil:
+		fm.removeLine(*pgvImport)
+	}
+	if hasAPI {
+		return
+	}
+	if last != nil {
+		fm.edits = append(fm.edits, edit{start: last.end, end: last.end, text: "\n" + stmt})
+		return
+	}
+	if pkg, ok := fm.exact(filePackage); ok {
+		fm.edits = append(fm.edits, edit{start: pkg.end, end: pkg.end, text: "\n\n" + stmt})
+		return
+	}
+	fm.edits = append(fm.edits, edit{text: stmt + "\n"})
+}
+
+func (fm *fileMigrator) apply() ([]byte, error) {
+	sort.SliceStable(fm.edits, func(i, j int) bool { return fm.edits[i].start < fm.edits[j].start })
+	var b strings.Builder
+	pos := 0
+	for _, e := range fm.edits {
+		if e.start < pos {
+			return nil, fmt.Errorf("overlapped edits at offset %d", e.start)
+		}
+		b.Write(fm.src[pos:e.start])
+		b.WriteString(e.text)
+		pos = e.end
+	}
+	b.Write(fm.src[pos:])
+	return []byte(b.String()), nil
+}
+
+// vtExpression writes f as a vt function.
+func vtExpression(f *parser.ToolFunction) (string, error) {
+	for _, arg := range f.Arguments {
+		switch arg.ValueType {
+		case parser.BoolValue:
+			return "", fmt.Errorf("bool literals can not be written in the functions of vt")
+		case parser.FunctionValue:
+			if _, err := vtExpression(arg.TypedValue.Function); err != nil {
+				return "", err
+			}
+		}
+	}
+	return f.String(), nil
+}
+
+// protoString quotes s as a string literal of the proto language.
+func protoString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if c < 0x20 || c == 0x7f {
+				fmt.Fprintf(&b, "\\%03o", c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
@@ -0,0 +1,109 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func TestMigrate(t *testing.T) {
+	tests := []struct {
+		set     string
+		file    string
+		reports []string
+	}{
+		{"../testdata/pgv.pb", "pgv.proto", []string{
+			"pgv.proto:20:22: fixture.Item.outside: rule gt/lt is dropped, exclusive ranges can not be expressed",
+			"pgv.proto:25:29: fixture.Item.tags: rule unique is dropped, there is no uniqueness check for lists",
+			"pgv.proto:29:22: fixture.Item.email: rule email is dropped, there is no built-in check for it",
+			"pgv.proto:30:38: fixture.Item.ttl: rule duration.gt is dropped, message fields only support skip and not_nil",
+			"pgv.proto:40:3: fixture.Disabled: the protoc-gen-validate rules are disabled, they are kept",
+		}},
+		{"../testdata/vd.pb", "vd.proto", []string{
+			"vd.proto:17:20: fixture.Req.nick: the msg of (api.vd) is dropped",
+			"vd.proto:26:20: fixture.Req.bad: (api.vd) is kept, function email is not supported at column 1",
+			"vd.proto:27:21: fixture.Req.bad2: (api.vd) is kept, regexp() is only supported as a top-level condition at column 13",
+			"vd.proto:28:20: fixture.Req.bad3: (api.vd) is kept, operator % is not supported at column 2",
+			"vd.proto:29:21: fixture.Req.bad4: (api.vd) is kept, no field Nope in fixture.Req at column 7",
+			"vd.proto:30:17: fixture.Req.ok: (api.vd) is kept, bool literals can not be written in the functions of vt",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			b, err := ioutil.ReadFile(tt.set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			set := &descriptorpb.FileDescriptorSet{}
+			if err = proto.Unmarshal(b, set); err != nil {
+				t.Fatal(err)
+			}
+			src, err := ioutil.ReadFile(filepath.Join("../testdata", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			m, err := NewMigrator(set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, reports, err := m.Migrate(tt.file, src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, r := range reports {
+				got = append(got, r.String())
+			}
+			if !reflect.DeepEqual(got, tt.reports) {
+				t.Errorf("reports = %q, want %q", got, tt.reports)
+			}
+			plugintest.GoldenFile(t, filepath.Join("testdata", tt.file+".golden"), string(out))
+		})
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	b, err := ioutil.ReadFile("../testdata/vd.pb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err = proto.Unmarshal(b, set); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewMigrator(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = m.Migrate("nothing.proto", nil); err == nil {
+		t.Error("Migrate() of a file not in the set succeeded")
+	}
+	for _, f := range set.GetFile() {
+		f.SourceCodeInfo = nil
+	}
+	if m, err = NewMigrator(set); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = m.Migrate("vd.proto", nil); err == nil {
+		t.Error("Migrate() of a file without source info succeeded")
+	}
+}
@@ -0,0 +1,333 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/jsonschema"
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	contentJSON      = "application/json"
+	contentForm      = "application/x-www-form-urlencoded"
+	contentMultipart = "multipart/form-data"
+	contentRaw       = "application/octet-stream"
+)
+
+// routeOptions are the hz method options declaring the routes, in the order
+// they are listed in api.proto.
+var routeOptions = []struct {
+	method string
+	ext    protoreflect.ExtensionType
+}{
+	{"get", api.E_Get},
+	{"post", api.E_Post},
+	{"put", api.E_Put},
+	{"delete", api.E_Delete},
+	{"patch", api.E_Patch},
+	{"options", api.E_Options},
+	{"head", api.E_Head},
+}
+
+// anyMethods are the methods documented for api.any, OpenAPI has no wildcard method.
+var anyMethods = []string{"get", "post", "put", "delete", "patch"}
+
+// locations are the hz field options binding a field to a part of the request,
+// the first one present wins.
+var locations = []struct {
+	in  string
+	ext protoreflect.ExtensionType
+}{
+	{"path", api.E_Path},
+	{"query", api.E_Query},
+	{"header", api.E_Header},
+	{"cookie", api.E_Cookie},
+	{"form", api.E_Form},
+	{"body", api.E_Body},
+	{"raw_body", api.E_RawBody},
+}
+
+type route struct {
+	method string
+	path   string
+}
+
+// Generator writes a <name>.openapi.json for a proto file declaring hz routes.
+type Generator struct {
+	*protogen.Plugin
+	PbFile *protogen.File
+
+	builder *jsonschema.Builder
+	parser  *parser.Parser
+}
+
+func NewGenerator(plu *protogen.Plugin, file *protogen.File) *Generator {
+	return &Generator{
+		Plugin:  plu,
+		PbFile:  file,
+		builder: jsonschema.NewBuilder("#/components/schemas/", FieldName),
+		parser:  parser.NewParser(),
+	}
+}
+
+// SetSeverity sets the severity of the rules without one, error or warn, like the
+// severity parameter of the plugin. The warn rules only go to the vendor extension.
+func (g *Generator) SetSeverity(severity string) {
+	g.builder.Severity = severity
+}
+
+// FieldName names the properties as the json tags generated by hz, which are
+// the api.body names or the proto field names.
+func FieldName(f *protogen.Field) string {
+	if name := proto.GetExtension(f.Desc.Options(), api.E_Body).(string); name != "" {
+		return name
+	}
+	return string(f.Desc.Name())
+}
+
+func (g *Generator) Generate() error {
+	doc := &Document{
+		OpenAPI: Version,
+		Info: &Info{
+			Title:   string(g.PbFile.Desc.Package()),
+			Version: "1.0.0",
+		},
+		Paths: make(map[string]*PathItem),
+	}
+	if doc.Info.Title == "" {
+		doc.Info.Title = g.PbFile.Desc.Path()
+	}
+	for _, svc := range g.PbFile.Services {
+		tag := string(svc.Desc.Name())
+		doc.Tags = append(doc.Tags, &Tag{Name: tag, Description: jsonschema.Comment(svc.Comments.Leading)})
+		for _, method := range svc.Methods {
+			routes := methodRoutes(method)
+			for _, r := range routes {
+				op, err := g.operation(method, r)
+				if err != nil {
+					return fmt.Errorf("generate openapi for %s failed: %w", method.Desc.FullName(), err)
+				}
+				op.Tags = []string{tag}
+				op.OperationID = tag + "_" + string(method.Desc.Name())
+				if len(routes) > 1 {
+					op.OperationID += "_" + r.method
+				}
+				p := openAPIPath(r.path)
+				item, ok := doc.Paths[p]
+				if !ok {
+					item = &PathItem{}
+					doc.Paths[p] = item
+				}
+				slot := item.operation(r.method)
+				if *slot != nil {
+					return fmt.Errorf("route %s %s is declared by both %s and %s", strings.ToUpper(r.method), r.path, (*slot).OperationID, op.OperationID)
+				}
+				*slot = op
+			}
+		}
+	}
+	if len(doc.Paths) == 0 {
+		return nil
+	}
+	if g.builder.Definitions().Len() > 0 {
+		doc.Components = &Components{Schemas: g.builder.Definitions()}
+	}
+	data, err := jsonschema.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	genFile := g.NewGeneratedFile(g.PbFile.GeneratedFilenamePrefix+".openapi.json", "")
+	genFile.Write(data)
+	return nil
+}
+
+func methodRoutes(method *protogen.Method) []route {
+	opts := method.Desc.Options()
+	var routes []route
+	for _, opt := range routeOptions {
+		if path := proto.GetExtension(opts, opt.ext).(string); path != "" {
+			routes = append(routes, route{method: opt.method, path: path})
+		}
+	}
+	if path := proto.GetExtension(opts, api.E_Any).(string); path != "" {
+		for _, m := range anyMethods {
+			routes = append(routes, route{method: m, path: path})
+		}
+	}
+	return routes
+}
+
+// openAPIPath converts the hz path parameters ":name" and "*name" to "{name}".
+func openAPIPath(path string) string {
+	segs := strings.Split(path, "/")
+	for i, seg := range segs {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			segs[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segs, "/")
+}
+
+func pathParams(path string) []string {
+	var ret []string
+	for _, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			ret = append(ret, seg[1:])
+		}
+	}
+	return ret
+}
+
+// location returns where hz binds the field from. The fields without binding
+// options are bound from the query for get and head, and from the json body otherwise.
+func location(f *protogen.Field, method string) (in, name string) {
+	opts := f.Desc.Options()
+	for _, loc := range locations {
+		if proto.HasExtension(opts, loc.ext) {
+			name = proto.GetExtension(opts, loc.ext).(string)
+			if name == "" {
+				name = string(f.Desc.Name())
+			}
+			return loc.in, name
+		}
+	}
+	if method == "get" || method == "head" {
+		return "query", string(f.Desc.Name())
+	}
+	return "body", string(f.Desc.Name())
+}
+
+func (g *Generator) operation(method *protogen.Method, r route) (*Operation, error) {
+	op := &Operation{
+		Responses: map[string]*Response{
+			"200": {Description: "OK"},
+		},
+	}
+	summary := jsonschema.Comment(method.Comments.Leading)
+	if i := strings.Index(summary, "\n"); i >= 0 {
+		op.Summary, op.Description = summary[:i], summary
+	} else {
+		op.Summary = summary
+	}
+	if method.Output.Desc.FullName() != "google.protobuf.Empty" {
+		ref, err := g.builder.Ref(method.Output)
+		if err != nil {
+			return nil, err
+		}
+		op.Responses["200"].Content = map[string]*MediaType{contentJSON: {Schema: ref}}
+	}
+
+	msgValidation, fieldValidations, err := g.parser.Parse(method.Input)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		body     = &jsonschema.Schema{Type: "object", Properties: jsonschema.NewSchemas()}
+		form     = &jsonschema.Schema{Type: "object", Properties: jsonschema.NewSchemas()}
+		raw      *jsonschema.Schema
+		declared = make(map[string]bool)
+		onlyBody = true
+	)
+	for _, f := range method.Input.Fields {
+		s, required, err := g.builder.Field(f, fieldValidations[f.Desc.Number()])
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", f.Desc.Name(), err)
+		}
+		in, name := location(f, r.method)
+		switch in {
+		case "body", "form":
+			obj := body
+			if in == "form" {
+				obj, onlyBody = form, false
+			} else if name != FieldName(f) {
+				onlyBody = false
+			}
+			obj.Properties.Set(name, s)
+			if required {
+				obj.Required = append(obj.Required, name)
+			}
+		case "raw_body":
+			raw, onlyBody = &jsonschema.Schema{Type: "string", Format: "binary", Description: s.Description}, false
+		default:
+			onlyBody = false
+			if in == "path" {
+				declared[name] = true
+				required = true
+			}
+			param := &Parameter{Name: name, In: in, Description: s.Description, Required: required, Schema: s}
+			s.Description = ""
+			op.Parameters = append(op.Parameters, param)
+		}
+	}
+	for _, name := range pathParams(r.path) {
+		if !declared[name] {
+			op.Parameters = append(op.Parameters, &Parameter{Name: name, In: "path", Required: true, Schema: &jsonschema.Schema{Type: "string"}})
+		}
+	}
+
+	switch {
+	case onlyBody && len(method.Input.Fields) > 0:
+		// the whole request is the json body, refer to the message schema
+		ref, err := g.builder.Ref(method.Input)
+		if err != nil {
+			return nil, err
+		}
+		op.RequestBody = &RequestBody{Required: len(body.Required) > 0, Content: map[string]*MediaType{contentJSON: {Schema: ref}}}
+		return op, nil
+	case raw != nil:
+		op.RequestBody = &RequestBody{Content: map[string]*MediaType{contentRaw: {Schema: raw}}}
+	case body.Properties.Len() > 0:
+		op.RequestBody = &RequestBody{Required: len(body.Required) > 0, Content: map[string]*MediaType{contentJSON: {Schema: body}}}
+	case form.Properties.Len() > 0:
+		op.RequestBody = &RequestBody{Required: len(form.Required) > 0, Content: map[string]*MediaType{
+			contentForm:      {Schema: form},
+			contentMultipart: {Schema: form},
+		}}
+	}
+	for _, rule := range msgValidation.Rules {
+		if rule.Key != parser.Assert && rule.Key != parser.OneofRequired {
+			return nil, fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+		if op.VT == nil {
+			op.VT = make(map[string]interface{})
+		}
+		vt := op.VT
+		if rule.IsWarn(g.builder.Severity) {
+			// the warn rules are reported by Validate but don't reject the request
+			warn, ok := op.VT["warn"].(map[string]interface{})
+			if !ok {
+				warn = make(map[string]interface{})
+				op.VT["warn"] = warn
+			}
+			vt = warn
+		}
+		key := parser.KeyString[rule.Key]
+		if rule.Key == parser.OneofRequired {
+			// the names of all the required oneofs
+			names, _ := vt[key].([]string)
+			vt[key] = append(names, rule.Specified.TypedValue.Binary)
+			continue
+		}
+		vt[key] = rule.Specified.String()
+	}
+	return op, nil
+}
@@ -0,0 +1,34 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+)
+
+func TestGenerate(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/hz.pb", "", "hz.proto")
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		if err := NewGenerator(gen, f).Generate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plugintest.Golden(t, gen, "testdata")
+}
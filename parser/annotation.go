@@ -21,8 +21,33 @@ import (
 
 const (
 	validatorPrefix = "vt"
+	// the message templates, the condition and the severity of FieldRules, which
+	// are not rules
+	messageKey  = "msg"
+	messagesKey = "msgs"
+	whenKey     = "when"
+	severityKey = "severity"
+	// the transforms of Normalize, which are not rules either
+	defaultKey = "default"
+	trimKey    = "trim"
+	lowerKey   = "lower"
+	upperKey   = "upper"
+	// the name of the rule set expanded by the parser
+	useKey = "use"
+	// the options of the rules of floats
+	epsilonKey  = "epsilon"
+	allowNaNKey = "allow_nan"
 )
 
+// isRuleKey reports whether a key of FieldRules is a rule checked by Validate.
+func isRuleKey(k string) bool {
+	switch k {
+	case messageKey, messagesKey, whenKey, severityKey, defaultKey, trimKey, lowerKey, upperKey, useKey, epsilonKey, allowNaNKey:
+		return false
+	}
+	return true
+}
+
 type Key int
 
 const (
@@ -48,6 +73,13 @@ const (
 	NoSparse
 	Skip
 	Assert
+	// OneofRequired is translated from (validate.required) of a oneof, the
+	// specified value is the name of the oneof.
+	OneofRequired
+	// Finite rejects NaN and the infinities of floats.
+	Finite
+	// MultipleOf is the positive divisor of integers.
+	MultipleOf
 	_max_key
 )
 
@@ -56,6 +88,9 @@ var (
 		In,
 		NotIn,
 	}
+	// NumericKeys are the keys of the integers and the floats.
+	//
+	// Deprecated: use IntKeys or DoubleKeys, NumericKeys is the union of them.
 	NumericKeys = []Key{
 		Const,
 		LessThan,
@@ -65,6 +100,30 @@ var (
 		In,
 		NotIn,
 		NotNil,
+		MultipleOf,
+		Finite,
+	}
+	IntKeys = []Key{
+		Const,
+		LessThan,
+		LessEqual,
+		GreatThan,
+		GreatEqual,
+		In,
+		NotIn,
+		NotNil,
+		MultipleOf,
+	}
+	DoubleKeys = []Key{
+		Const,
+		LessThan,
+		LessEqual,
+		GreatThan,
+		GreatEqual,
+		In,
+		NotIn,
+		NotNil,
+		Finite,
 	}
 	BinaryKeys = []Key{
 		Const,
@@ -87,6 +146,8 @@ var (
 		Const,
 		DefinedOnly,
 		NotNil,
+		In,
+		NotIn,
 	}
 	ListKeys = []Key{
 		MinSize,
@@ -132,6 +193,10 @@ var KeyString = [...]string{
 	NoSparse:    "no_sparse",
 	Skip:        "skip",
 	Assert:      "assert",
+
+	OneofRequired: "oneof_required",
+	Finite:        "finite",
+	MultipleOf:    "multiple_of",
 }
 
 func (k Key) String() (string, bool) {
@@ -0,0 +1,555 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type celType int
+
+const (
+	celInt celType = iota
+	celUint
+	celDouble
+	celString
+	celBytes
+	celBool
+	celList
+	celMap
+	celMessage
+)
+
+var celTypeName = [...]string{
+	celInt:     "int",
+	celUint:    "uint",
+	celDouble:  "double",
+	celString:  "string",
+	celBytes:   "bytes",
+	celBool:    "bool",
+	celList:    "list",
+	celMap:     "map",
+	celMessage: "message",
+}
+
+// celExpr is a compiled sub-expression.
+type celExpr struct {
+	value   ValidationValue
+	typ     celType
+	literal bool
+	// selected is set for the fields selected from the message, has() only
+	// accepts them.
+	selected bool
+	// this is set for this referring to the message.
+	this bool
+}
+
+// CompileCEL compiles a CEL expression to a function of the built-in functions,
+// which is used as the assert of msg. this is the message when field is nil, or
+// the value of field. Only the common subset of CEL is supported: literals, this
+// and the fields of the message, size(), has(), comparisons, !, && and ||.
+func CompileCEL(msg *protogen.Message, field *protogen.Field, expr string) (*ToolFunction, error) {
+	c := &celCompiler{msg: msg, field: field, src: expr}
+	if err := c.next(); err != nil {
+		return nil, err
+	}
+	e, err := c.or()
+	if err != nil {
+		return nil, err
+	}
+	if c.tok.kind != celEOF {
+		return nil, c.errorf("unexpected %q", c.tok.text)
+	}
+	if e.typ != celBool {
+		return nil, fmt.Errorf("the expression is a %s instead of a bool", celTypeName[e.typ])
+	}
+	if e.value.ValueType != FunctionValue {
+		// a bool field or literal on its own
+		return call("equal", e.value, boolValue(true)), nil
+	}
+	return e.value.TypedValue.Function, nil
+}
+
+type celCompiler struct {
+	msg   *protogen.Message
+	field *protogen.Field
+	src   string
+	pos   int
+	tok   celToken
+}
+
+type celTokenKind int
+
+const (
+	celEOF celTokenKind = iota
+	celIdent
+	celNumber
+	celStringLit
+	celOperator
+)
+
+type celToken struct {
+	kind celTokenKind
+	text string
+	pos  int
+	// str is the unquoted value of a string literal
+	str string
+}
+
+func (c *celCompiler) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("%s at column %d", fmt.Sprintf(format, a...), c.tok.pos+1)
+}
+
+var celOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", ".", ",", "+", "-", "*", "/", "%", "?", ":", "[", "]", "{", "}"}
+
+func (c *celCompiler) next() error {
+	for c.pos < len(c.src) && unicode.IsSpace(rune(c.src[c.pos])) {
+		c.pos++
+	}
+	start := c.pos
+	c.tok = celToken{pos: start}
+	if c.pos >= len(c.src) {
+		c.tok.kind = celEOF
+		return nil
+	}
+	ch := c.src[c.pos]
+	switch {
+	case ch == '_' || unicode.IsLetter(rune(ch)):
+		for c.pos < len(c.src) && (c.src[c.pos] == '_' || unicode.IsLetter(rune(c.src[c.pos])) || unicode.IsDigit(rune(c.src[c.pos]))) {
+			c.pos++
+		}
+		c.tok.kind = celIdent
+		if word := c.src[start:c.pos]; c.pos < len(c.src) && (c.src[c.pos] == '"' || c.src[c.pos] == '\'') && strings.ContainsAny(word, "rRbB") && len(word) <= 2 {
+			return c.errorf("raw and bytes literals are not supported")
+		}
+	case unicode.IsDigit(rune(ch)):
+		for c.pos < len(c.src) && (strings.IndexByte("0123456789abcdefABCDEFxXuU.", c.src[c.pos]) >= 0 ||
+			(c.src[c.pos] == '+' || c.src[c.pos] == '-') && (c.src[c.pos-1] == 'e' || c.src[c.pos-1] == 'E') && !strings.HasPrefix(c.src[start:], "0x")) {
+			c.pos++
+		}
+		c.tok.kind = celNumber
+	case ch == '"' || ch == '\'':
+		quote := ch
+		c.pos++
+		var b strings.Builder
+		for {
+			if c.pos >= len(c.src) {
+				return c.errorf("unterminated string")
+			}
+			ch := c.src[c.pos]
+			if ch == quote {
+				c.pos++
+				break
+			}
+			if ch == '\\' && c.pos+1 < len(c.src) {
+				c.pos++
+				switch esc := c.src[c.pos]; esc {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				case 'r':
+					b.WriteByte('\r')
+				case '\\', '"', '\'', '`', '?':
+					b.WriteByte(esc)
+				default:
+					return c.errorf("unsupported escape \\%c", esc)
+				}
+				c.pos++
+				continue
+			}
+			b.WriteByte(ch)
+			c.pos++
+		}
+		c.tok.kind = celStringLit
+		c.tok.str = b.String()
+	default:
+		for _, op := range celOperators {
+			if strings.HasPrefix(c.src[c.pos:], op) {
+				c.pos += len(op)
+				c.tok.kind = celOperator
+				c.tok.text = op
+				return nil
+			}
+		}
+		return c.errorf("unexpected character %q", ch)
+	}
+	c.tok.text = c.src[start:c.pos]
+	return nil
+}
+
+func (c *celCompiler) is(op string) bool {
+	return c.tok.kind == celOperator && c.tok.text == op
+}
+
+func (c *celCompiler) expect(op string) error {
+	if !c.is(op) {
+		return c.errorf("expect %q", op)
+	}
+	return c.next()
+}
+
+func (c *celCompiler) or() (*celExpr, error) {
+	return c.logical("||", "or", c.and)
+}
+
+func (c *celCompiler) and() (*celExpr, error) {
+	return c.logical("&&", "and", c.relation)
+}
+
+// logical compiles a chain of && or ||, which is one function of all the operands.
+func (c *celCompiler) logical(op, name string, operand func() (*celExpr, error)) (*celExpr, error) {
+	e, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if !c.is(op) {
+		return e, nil
+	}
+	args := []ValidationValue{}
+	for {
+		if e.typ != celBool {
+			return nil, c.errorf("the operands of %s must be bool, got %s", op, celTypeName[e.typ])
+		}
+		args = append(args, e.value)
+		if !c.is(op) {
+			break
+		}
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+		if e, err = operand(); err != nil {
+			return nil, err
+		}
+	}
+	return &celExpr{value: functionValue(call(name, args...)), typ: celBool}, nil
+}
+
+var celRelations = map[string]string{"==": "equal", "!=": "ne", "<": "lt", "<=": "le", ">": "gt", ">=": "ge"}
+
+func (c *celCompiler) relation() (*celExpr, error) {
+	a, err := c.unary()
+	if err != nil {
+		return nil, err
+	}
+	if c.tok.kind != celOperator {
+		return a, nil
+	}
+	op := c.tok.text
+	name, ok := celRelations[op]
+	if !ok {
+		if strings.Contains("+-*/%?[{", op) {
+			return nil, c.errorf("operator %s is not supported", op)
+		}
+		return a, nil
+	}
+	if err = c.next(); err != nil {
+		return nil, err
+	}
+	b, err := c.unary()
+	if err != nil {
+		return nil, err
+	}
+	if err = comparable(op, a, b); err != nil {
+		return nil, err
+	}
+	return &celExpr{value: functionValue(call(name, a.value, b.value)), typ: celBool}, nil
+}
+
+// comparable reports whether a and b can be compared in the generated code.
+func comparable(op string, a, b *celExpr) error {
+	mismatch := fmt.Errorf("can not compare %s with %s", celTypeName[a.typ], celTypeName[b.typ])
+	switch {
+	case a.literal && b.literal:
+		return errors.New("both operands are literals")
+	case a.typ == b.typ:
+	case a.typ == celInt && b.typ == celUint && b.literal || a.typ == celUint && b.typ == celInt && a.literal:
+		// non-negative int literals can be compared with uints
+		lit := a
+		if b.literal {
+			lit = b
+		}
+		if lit.value.TypedValue.Int < 0 {
+			return mismatch
+		}
+	case a.typ == celInt && b.typ == celDouble && a.literal || a.typ == celDouble && b.typ == celInt && b.literal:
+		// int literals can be compared with doubles
+	default:
+		return mismatch
+	}
+	switch a.typ {
+	case celList, celMap, celMessage:
+		return fmt.Errorf("%s values can not be compared", celTypeName[a.typ])
+	case celBool:
+		if op != "==" && op != "!=" {
+			return fmt.Errorf("bool values can not be compared with %s", op)
+		}
+	}
+	return nil
+}
+
+func (c *celCompiler) unary() (*celExpr, error) {
+	switch {
+	case c.is("!"):
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.unary()
+		if err != nil {
+			return nil, err
+		}
+		if e.typ != celBool {
+			return nil, c.errorf("the operand of ! must be bool, got %s", celTypeName[e.typ])
+		}
+		return &celExpr{value: functionValue(call("not", e.value)), typ: celBool}, nil
+	case c.is("-"):
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.unary()
+		if err != nil {
+			return nil, err
+		}
+		if !e.literal || e.typ != celInt && e.typ != celDouble {
+			return nil, c.errorf("negation is only supported for int and double literals")
+		}
+		e.value.TypedValue.Int = -e.value.TypedValue.Int
+		e.value.TypedValue.Double = -e.value.TypedValue.Double
+		return e, nil
+	}
+	return c.member()
+}
+
+func (c *celCompiler) member() (*celExpr, error) {
+	e, err := c.primary()
+	if err != nil {
+		return nil, err
+	}
+	for c.is(".") {
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+		if c.tok.kind != celIdent {
+			return nil, c.errorf("expect a field or method name")
+		}
+		name := c.tok.text
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+		if c.is("(") {
+			args, err := c.arguments()
+			if err != nil {
+				return nil, err
+			}
+			if e, err = c.function(name, append([]*celExpr{e}, args...)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if !e.this {
+			return nil, c.errorf("only the fields of this can be selected")
+		}
+		f := c.fieldByName(name)
+		if f == nil {
+			return nil, c.errorf("no field %s in %s", name, c.msg.Desc.FullName())
+		}
+		e = fieldExpr(f)
+		e.selected = true
+	}
+	return e, nil
+}
+
+func (c *celCompiler) fieldByName(name string) *protogen.Field {
+	for _, f := range c.msg.Fields {
+		if string(f.Desc.Name()) == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (c *celCompiler) arguments() ([]*celExpr, error) {
+	if err := c.expect("("); err != nil {
+		return nil, err
+	}
+	var args []*celExpr
+	for !c.is(")") {
+		arg, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if !c.is(",") {
+			break
+		}
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+	}
+	return args, c.expect(")")
+}
+
+func (c *celCompiler) primary() (*celExpr, error) {
+	tok := c.tok
+	switch tok.kind {
+	case celEOF:
+		return nil, c.errorf("unexpected end of expression")
+	case celNumber:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		return numberExpr(tok.text)
+	case celStringLit:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		return &celExpr{value: ValidationValue{ValueType: BinaryValue, TypedValue: TypedValidationValue{Binary: tok.str}}, typ: celString, literal: true}, nil
+	case celIdent:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		switch tok.text {
+		case "true", "false":
+			return &celExpr{value: boolValue(tok.text == "true"), typ: celBool, literal: true}, nil
+		case "this":
+			if c.field != nil {
+				return fieldExpr(c.field), nil
+			}
+			return &celExpr{typ: celMessage, this: true}, nil
+		}
+		if c.is("(") {
+			args, err := c.arguments()
+			if err != nil {
+				return nil, err
+			}
+			return c.function(tok.text, args)
+		}
+		return nil, fmt.Errorf("unknown identifier %s at column %d", tok.text, tok.pos+1)
+	}
+	if c.is("(") {
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		return e, c.expect(")")
+	}
+	return nil, c.errorf("unexpected %q", tok.text)
+}
+
+// function compiles the functions and the methods, the receiver of a method is
+// the first argument.
+func (c *celCompiler) function(name string, args []*celExpr) (*celExpr, error) {
+	switch name {
+	case "size":
+		if len(args) != 1 {
+			return nil, errors.New("size() needs one argument")
+		}
+		a := args[0]
+		if a.value.ValueType != FieldReferenceValue {
+			return nil, errors.New("size() is only supported for fields")
+		}
+		switch a.typ {
+		case celString, celBytes, celList, celMap:
+		default:
+			return nil, fmt.Errorf("size() is not applicable for %s", celTypeName[a.typ])
+		}
+		return &celExpr{value: functionValue(call("size", a.value)), typ: celInt}, nil
+	case "has":
+		if len(args) != 1 || !args[0].selected {
+			return nil, errors.New("has() needs a field of this")
+		}
+		return &celExpr{value: functionValue(call("has", args[0].value)), typ: celBool}, nil
+	default:
+		return nil, fmt.Errorf("function %s is not supported", name)
+	}
+}
+
+func fieldExpr(f *protogen.Field) *celExpr {
+	e := &celExpr{value: ValidationValue{ValueType: FieldReferenceValue, TypedValue: TypedValidationValue{FieldReference: f}}}
+	switch {
+	case f.Desc.IsMap():
+		e.typ = celMap
+	case f.Desc.IsList():
+		e.typ = celList
+	default:
+		switch f.Desc.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.EnumKind:
+			e.typ = celInt
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			e.typ = celUint
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			e.typ = celDouble
+		case protoreflect.StringKind:
+			e.typ = celString
+		case protoreflect.BytesKind:
+			e.typ = celBytes
+		case protoreflect.BoolKind:
+			e.typ = celBool
+		default:
+			e.typ = celMessage
+		}
+	}
+	return e
+}
+
+func numberExpr(text string) (*celExpr, error) {
+	e := &celExpr{literal: true}
+	lower := strings.ToLower(text)
+	switch {
+	case strings.HasSuffix(lower, "u"):
+		u, err := strconv.ParseUint(lower[:len(lower)-1], 0, 64)
+		if err != nil || u > math.MaxInt64 {
+			return nil, fmt.Errorf("invalid uint literal %s", text)
+		}
+		e.typ, e.value = celUint, ValidationValue{ValueType: IntValue, TypedValue: TypedValidationValue{Int: int64(u)}}
+	case !strings.HasPrefix(lower, "0x") && strings.ContainsAny(lower, ".e"):
+		d, err := strconv.ParseFloat(lower, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid double literal %s", text)
+		}
+		e.typ, e.value = celDouble, ValidationValue{ValueType: DoubleValue, TypedValue: TypedValidationValue{Double: d}}
+	default:
+		i, err := strconv.ParseInt(lower, 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int literal %s", text)
+		}
+		e.typ, e.value = celInt, ValidationValue{ValueType: IntValue, TypedValue: TypedValidationValue{Int: i}}
+	}
+	return e, nil
+}
+
+func call(name string, args ...ValidationValue) *ToolFunction {
+	return &ToolFunction{Name: name, Arguments: args}
+}
+
+func functionValue(f *ToolFunction) ValidationValue {
+	return ValidationValue{ValueType: FunctionValue, TypedValue: TypedValidationValue{Function: f}}
+}
+
+func boolValue(b bool) ValidationValue {
+	return ValidationValue{ValueType: BoolValue, TypedValue: TypedValidationValue{Bool: b}}
+}
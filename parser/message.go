@@ -0,0 +1,167 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// applyMessages sets the message templates of FieldRules to the rules, the ones
+// of the key, value and elem are set to the inner rules.
+func applyMessages(v *Validation, rules *api.FieldRules) error {
+	if v == nil || rules == nil {
+		return nil
+	}
+	for name := range rules.GetMsgs() {
+		if _, ok := KeyFromString(name); !ok {
+			return fmt.Errorf("unknown rule %s in msgs", name)
+		}
+	}
+	for _, rule := range v.Rules {
+		var err error
+		switch rule.Key {
+		case Elem:
+			err = applyMessages(rule.Inner, rules.GetElem())
+		case MapKey:
+			err = applyMessages(rule.Inner, rules.GetKey())
+		case MapValue:
+			err = applyMessages(rule.Inner, rules.GetValue())
+		}
+		if err != nil {
+			return err
+		}
+		name, _ := rule.Key.String()
+		if msg, ok := rules.GetMsgs()[name]; ok {
+			rule.Message = msg
+		} else {
+			rule.Message = rules.GetMsg()
+		}
+	}
+	return nil
+}
+
+// applySeverity sets the severity of FieldRules to the rules, the inner rules of
+// the key, value and elem inherit the severity unless they have their own.
+func applySeverity(v *Validation, rules *api.FieldRules, inherited string) error {
+	if v == nil {
+		return nil
+	}
+	severity := inherited
+	switch rules.GetSeverity() {
+	case "":
+	case SeverityError, SeverityWarn:
+		severity = rules.GetSeverity()
+	default:
+		return fmt.Errorf("unknown severity %s", rules.GetSeverity())
+	}
+	for _, rule := range v.Rules {
+		var err error
+		switch rule.Key {
+		case Elem:
+			err = applySeverity(rule.Inner, rules.GetElem(), severity)
+		case MapKey:
+			err = applySeverity(rule.Inner, rules.GetKey(), severity)
+		case MapValue:
+			err = applySeverity(rule.Inner, rules.GetValue(), severity)
+		}
+		if err != nil {
+			return err
+		}
+		rule.Severity = severity
+	}
+	return nil
+}
+
+// applyWhen sets the when of FieldRules to the validation of a field, which is not
+// applicable to the key, value and elem.
+func applyWhen(msg *protogen.Message, v *Validation, rules *api.FieldRules) error {
+	if v == nil || rules == nil {
+		return nil
+	}
+	for _, inner := range []*api.FieldRules{rules.GetElem(), rules.GetKey(), rules.GetValue()} {
+		if inner.GetWhen() != "" {
+			return errors.New("when is only applicable to fields")
+		}
+	}
+	if rules.GetWhen() == "" {
+		return nil
+	}
+	value, err := getFunctionValidation(msg, rules.GetWhen())
+	if err != nil {
+		return fmt.Errorf("parse when %s failed: %w", rules.GetWhen(), err)
+	}
+	if value == nil {
+		return fmt.Errorf("when %s is not a function", rules.GetWhen())
+	}
+	switch value.TypedValue.Function.Name {
+	case "len", "size", "sprintf", "mod", "add", "now_unix_nano":
+		return fmt.Errorf("when %s does not return a bool", rules.GetWhen())
+	}
+	v.When = value.TypedValue.Function
+	return nil
+}
+
+// applyFloatOptions sets the epsilon and allow_nan of FieldRules to the validation
+// of a float field, the ones of the elem and value are set to the inner validations.
+func applyFloatOptions(fd protoreflect.FieldDescriptor, v *Validation, rules *api.FieldRules) error {
+	if v == nil || rules == nil {
+		return nil
+	}
+	for _, rule := range v.Rules {
+		var err error
+		switch rule.Key {
+		case Elem:
+			err = applyFloatOptions(fd, rule.Inner, rules.GetElem())
+		case MapKey:
+			err = applyFloatOptions(fd.MapKey(), rule.Inner, rules.GetKey())
+		case MapValue:
+			err = applyFloatOptions(fd.MapValue(), rule.Inner, rules.GetValue())
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if rules.Epsilon == nil && rules.AllowNan == nil {
+		return nil
+	}
+	if v.ValidationType != NumericValidation || (fd.Kind() != protoreflect.FloatKind && fd.Kind() != protoreflect.DoubleKind) {
+		return errors.New("epsilon and allow_nan are only applicable to floats")
+	}
+	if rules.Epsilon != nil {
+		eps, err := strconv.ParseFloat(rules.GetEpsilon(), 64)
+		if err != nil {
+			return fmt.Errorf("parse epsilon failed: %v", err)
+		}
+		if eps < 0 || math.IsNaN(eps) {
+			return errors.New("epsilon can not be negative")
+		}
+		v.Epsilon = eps
+	}
+	if rules.AllowNan != nil {
+		allow, err := strconv.ParseBool(rules.GetAllowNan())
+		if err != nil {
+			return fmt.Errorf("parse allow_nan failed: %v", err)
+		}
+		v.AllowNaN = allow
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestApplyWhen(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	request := gen.FilesByPath["vt.proto"].Messages[1]
+	tests := []struct {
+		name  string
+		rules *api.FieldRules
+		want  string
+		err   string
+	}{
+		{name: "none", rules: &api.FieldRules{MinSize: proto.String("1")}},
+		{name: "enum name", rules: &api.FieldRules{When: proto.String(`@equal($status, "STATUS_ACTIVE")`)}, want: `@equal($status, "STATUS_ACTIVE")`},
+		{name: "comparison", rules: &api.FieldRules{When: proto.String("@gt($page, 1)")}, want: "@gt($page, 1)"},
+		{name: "logical", rules: &api.FieldRules{When: proto.String("@and(@gt($page, 1), @has($ratio))")}, want: "@and(@gt($page, 1), @has($ratio))"},
+		{name: "not a bool", rules: &api.FieldRules{When: proto.String("@len($code)")}, err: "does not return a bool"},
+		{name: "not a function", rules: &api.FieldRules{When: proto.String("$page")}, err: "is not a function"},
+		{name: "unknown field", rules: &api.FieldRules{When: proto.String("@gt($nothing, 1)")}, err: "parse when"},
+		{name: "elem", rules: &api.FieldRules{Elem: &api.FieldRules{When: proto.String("@gt($page, 1)")}}, err: "only applicable to fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &Validation{}
+			err := applyWhen(request, v, tt.rules)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("applyWhen() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyWhen() failed: %v", err)
+			}
+			var got string
+			if v.When != nil {
+				got = v.When.String()
+			}
+			if got != tt.want {
+				t.Errorf("when = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplySeverity(t *testing.T) {
+	elem := func() *Validation {
+		return &Validation{Rules: []*Rule{
+			{Key: MaxSize},
+			{Key: Elem, Inner: &Validation{Rules: []*Rule{{Key: MinSize}}}},
+		}}
+	}
+	tests := []struct {
+		name      string
+		rules     *api.FieldRules
+		inherited string
+		want      []string
+		err       string
+	}{
+		{name: "default", rules: &api.FieldRules{}, want: []string{"max_size=", "elem.min_size="}},
+		{name: "inherited", rules: &api.FieldRules{}, inherited: SeverityWarn, want: []string{"max_size=warn", "elem.min_size=warn"}},
+		{name: "field", rules: &api.FieldRules{Severity: proto.String("warn")}, want: []string{"max_size=warn", "elem.min_size=warn"}},
+		{
+			name:  "elem",
+			rules: &api.FieldRules{Severity: proto.String("warn"), Elem: &api.FieldRules{Severity: proto.String("error")}},
+			want:  []string{"max_size=warn", "elem.min_size=error"},
+		},
+		{name: "unknown", rules: &api.FieldRules{Severity: proto.String("fatal")}, err: "unknown severity fatal"},
+		{name: "unknown elem", rules: &api.FieldRules{Elem: &api.FieldRules{Severity: proto.String("info")}}, err: "unknown severity info"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := elem()
+			err := applySeverity(v, tt.rules, tt.inherited)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("applySeverity() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := []string{
+				KeyString[v.Rules[0].Key] + "=" + v.Rules[0].Severity,
+				"elem." + KeyString[v.Rules[1].Inner.Rules[0].Key] + "=" + v.Rules[1].Inner.Rules[0].Severity,
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("severities = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWarn(t *testing.T) {
+	tests := []struct {
+		severity string
+		def      string
+		want     bool
+	}{
+		{"", "", false},
+		{"", SeverityWarn, true},
+		{SeverityWarn, SeverityError, true},
+		{SeverityError, SeverityWarn, false},
+	}
+	for _, tt := range tests {
+		if got := (&Rule{Severity: tt.severity}).IsWarn(tt.def); got != tt.want {
+			t.Errorf("IsWarn(%q) of %q = %v, want %v", tt.def, tt.severity, got, tt.want)
+		}
+	}
+}
+
+func TestApplyFloatOptions(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	fields := gen.FilesByPath["vt.proto"].Desc.Messages().ByName("Measure").Fields()
+	numeric := func() *Validation { return &Validation{ValidationType: NumericValidation} }
+	tests := []struct {
+		name     string
+		field    protoreflect.Name
+		v        *Validation
+		rules    *api.FieldRules
+		epsilon  float64
+		allowNaN bool
+		err      string
+	}{
+		{name: "none", field: "ratio", v: numeric(), rules: &api.FieldRules{Const: proto.String("0.3")}},
+		{name: "epsilon", field: "ratio", v: numeric(), rules: &api.FieldRules{Epsilon: proto.String("1e-3")}, epsilon: 0.001},
+		{name: "allow nan", field: "score", v: numeric(), rules: &api.FieldRules{AllowNan: proto.String("true")}, allowNaN: true},
+		{name: "negative epsilon", field: "ratio", v: numeric(), rules: &api.FieldRules{Epsilon: proto.String("-1")}, err: "epsilon can not be negative"},
+		{name: "nan epsilon", field: "ratio", v: numeric(), rules: &api.FieldRules{Epsilon: proto.String("NaN")}, err: "epsilon can not be negative"},
+		{name: "invalid epsilon", field: "ratio", v: numeric(), rules: &api.FieldRules{Epsilon: proto.String("small")}, err: "parse epsilon failed"},
+		{name: "invalid allow nan", field: "score", v: numeric(), rules: &api.FieldRules{AllowNan: proto.String("yes")}, err: "parse allow_nan failed"},
+		{name: "integer", field: "step", v: numeric(), rules: &api.FieldRules{Epsilon: proto.String("1")}, err: "only applicable to floats"},
+		{
+			name:  "list",
+			field: "weights",
+			v:     &Validation{ValidationType: ListValidation, Rules: []*Rule{{Key: Elem, Inner: numeric()}}},
+			rules: &api.FieldRules{AllowNan: proto.String("true")},
+			err:   "only applicable to floats",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := applyFloatOptions(fields.ByName(tt.field), tt.v, tt.rules)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("applyFloatOptions() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.v.Epsilon != tt.epsilon || tt.v.AllowNaN != tt.allowNaN {
+				t.Errorf("epsilon, allow_nan = %v, %v, want %v, %v", tt.v.Epsilon, tt.v.AllowNaN, tt.epsilon, tt.allowNaN)
+			}
+		})
+	}
+
+	// the options of elem are set to the validation of the elements
+	inner := numeric()
+	v := &Validation{ValidationType: ListValidation, Rules: []*Rule{{Key: Elem, Inner: inner}}}
+	if err := applyFloatOptions(fields.ByName("weights"), v, &api.FieldRules{Elem: &api.FieldRules{Epsilon: proto.String("0.5")}}); err != nil {
+		t.Fatal(err)
+	}
+	if inner.Epsilon != 0.5 || v.Epsilon != 0 {
+		t.Errorf("epsilon of elem = %v, of list = %v, want 0.5, 0", inner.Epsilon, v.Epsilon)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// MethodValidation tells which messages of a method are validated.
+type MethodValidation struct {
+	Request  bool
+	Response bool
+}
+
+// ParseMethod parses the (api.method_vt) of a method, the requests are validated
+// and the responses are not by default.
+func ParseMethod(m *protogen.Method) (*MethodValidation, error) {
+	ret := &MethodValidation{Request: true}
+	rules := proto.GetExtension(m.Desc.Options(), api.E_MethodVt).(*api.MethodRules)
+	if rules == nil {
+		return ret, nil
+	}
+	if rules.Request != nil {
+		val, err := strconv.ParseBool(rules.GetRequest())
+		if err != nil {
+			return nil, fmt.Errorf("method %s: parse request of method_vt failed: %v", m.Desc.FullName(), err)
+		}
+		ret.Request = val
+	}
+	if rules.Response != nil {
+		val, err := strconv.ParseBool(rules.GetResponse())
+		if err != nil {
+			return nil, fmt.Errorf("method %s: parse response of method_vt failed: %v", m.Desc.FullName(), err)
+		}
+		ret.Response = val
+	}
+	return ret, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Normalization is the transforms of a field applied by Normalize, see the default,
+// trim, lower and upper of FieldRules.
+type Normalization struct {
+	// Default is the value of the field if it's unset, the strings and bytes are
+	// the raw content in Binary.
+	Default *ValidationValue
+	Trim    bool
+	Lower   bool
+	Upper   bool
+	// Elem and Value are the transforms of the items of a list and the values of a map.
+	Elem  *Normalization
+	Value *Normalization
+}
+
+// ParseNormalization parses the transforms of a field, nil is returned if it has none.
+func ParseNormalization(field *protogen.Field) (*Normalization, error) {
+	rules, err := ResolveFieldRules(field.Desc)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %w", field.Desc.FullName(), err)
+	}
+	n, err := parseNormalization(field.Desc, rules, false)
+	if err != nil {
+		return nil, fmt.Errorf("field %s: %w", field.Desc.FullName(), err)
+	}
+	return n, nil
+}
+
+func parseNormalization(fd protoreflect.FieldDescriptor, rules *api.FieldRules, inner bool) (*Normalization, error) {
+	if rules == nil {
+		return nil, nil
+	}
+	if key := rules.GetKey(); key != nil && (key.Default != nil || key.Trim != nil || key.Lower != nil || key.Upper != nil) {
+		return nil, errors.New("default, trim, lower and upper are not applicable to key")
+	}
+	n := &Normalization{}
+	var err error
+	if n.Trim, err = parseTransform(trimKey, rules.Trim); err != nil {
+		return nil, err
+	}
+	if n.Lower, err = parseTransform(lowerKey, rules.Lower); err != nil {
+		return nil, err
+	}
+	if n.Upper, err = parseTransform(upperKey, rules.Upper); err != nil {
+		return nil, err
+	}
+	if n.Lower && n.Upper {
+		return nil, errors.New("lower and upper can not be both true")
+	}
+	transformed := n.Trim || n.Lower || n.Upper
+	switch {
+	case !inner && fd.IsList():
+		if transformed || rules.Default != nil {
+			return nil, errors.New("default, trim, lower and upper are not applicable to lists, use elem instead")
+		}
+		if n.Elem, err = parseNormalization(fd, rules.GetElem(), true); err != nil {
+			return nil, fmt.Errorf("elem: %w", err)
+		}
+	case !inner && fd.IsMap():
+		if transformed || rules.Default != nil {
+			return nil, errors.New("default, trim, lower and upper are not applicable to maps, use value instead")
+		}
+		if n.Value, err = parseNormalization(fd.MapValue(), rules.GetValue(), true); err != nil {
+			return nil, fmt.Errorf("value: %w", err)
+		}
+	default:
+		if transformed && fd.Kind() != protoreflect.StringKind {
+			return nil, errors.New("trim, lower and upper are only applicable to strings")
+		}
+		if rules.Default != nil {
+			if inner {
+				return nil, errors.New("default is not applicable to elem and value")
+			}
+			if n.Default, err = parseDefault(fd, rules.GetDefault()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if n.Default == nil && !transformed && n.Elem == nil && n.Value == nil {
+		return nil, nil
+	}
+	return n, nil
+}
+
+func parseTransform(key string, value *string) (bool, error) {
+	if value == nil {
+		return false, nil
+	}
+	ret, err := strconv.ParseBool(*value)
+	if err != nil {
+		return false, fmt.Errorf("parse %s failed: %v", key, err)
+	}
+	return ret, nil
+}
+
+// parseDefault parses the default of an optional scalar field, the enums accept
+// the names and the numbers of the values.
+func parseDefault(fd protoreflect.FieldDescriptor, value string) (*ValidationValue, error) {
+	if !fd.HasPresence() || fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind ||
+		(fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic()) {
+		return nil, errors.New("default is only applicable to the optional scalar fields")
+	}
+	var (
+		ret = &ValidationValue{}
+		err error
+	)
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		ret.ValueType = BoolValue
+		ret.TypedValue.Bool, err = strconv.ParseBool(value)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		ret.ValueType = IntValue
+		ret.TypedValue.Int, err = strconv.ParseInt(value, 10, 32)
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		ret.ValueType = IntValue
+		ret.TypedValue.Int, err = strconv.ParseInt(value, 10, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		var u uint64
+		bitSize := 64
+		if fd.Kind() == protoreflect.Uint32Kind || fd.Kind() == protoreflect.Fixed32Kind {
+			bitSize = 32
+		}
+		u, err = strconv.ParseUint(value, 10, bitSize)
+		// the uint64 values are kept in the bits of Int
+		ret.ValueType = IntValue
+		ret.TypedValue.Int = int64(u)
+	case protoreflect.FloatKind:
+		ret.ValueType = DoubleValue
+		ret.TypedValue.Double, err = strconv.ParseFloat(value, 32)
+	case protoreflect.DoubleKind:
+		ret.ValueType = DoubleValue
+		ret.TypedValue.Double, err = strconv.ParseFloat(value, 64)
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		ret.ValueType = BinaryValue
+		ret.TypedValue.Binary = value
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(value)); ev != nil {
+			ret.ValueType = EnumValue
+			ret.TypedValue.Int = int64(ev.Number())
+			ret.TypedValue.Binary = value
+			break
+		}
+		ret.ValueType = IntValue
+		ret.TypedValue.Int, err = strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("enum %s has no value %s", fd.Enum().FullName(), value)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse default %s failed: %v", value, err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// normalizationString formats n like "default=1 trim elem(upper)".
+func normalizationString(n *Normalization) string {
+	if n == nil {
+		return ""
+	}
+	var s []string
+	if n.Default != nil {
+		s = append(s, "default="+n.Default.String())
+	}
+	for _, t := range []struct {
+		name string
+		on   bool
+	}{{trimKey, n.Trim}, {lowerKey, n.Lower}, {upperKey, n.Upper}} {
+		if t.on {
+			s = append(s, t.name)
+		}
+	}
+	if n.Elem != nil {
+		s = append(s, "elem("+normalizationString(n.Elem)+")")
+	}
+	if n.Value != nil {
+		s = append(s, "value("+normalizationString(n.Value)+")")
+	}
+	return strings.Join(s, " ")
+}
+
+func TestParseNormalization(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	request := gen.FilesByPath["vt.proto"].Messages[1]
+	tests := []struct {
+		name  string
+		field protoreflect.Name
+		rules *api.FieldRules
+		want  string
+		err   string
+	}{
+		{name: "none", field: "code", rules: &api.FieldRules{Prefix: proto.String("CN-")}},
+		{name: "double default", field: "ratio", rules: &api.FieldRules{Default: proto.String("0.5")}, want: "default=0.5"},
+		{name: "bool default", field: "enabled", rules: &api.FieldRules{Default: proto.String("true")}, want: "default=true"},
+		{name: "transforms", field: "code", rules: &api.FieldRules{Trim: proto.String("true"), Lower: proto.String("true"), Upper: proto.String("false")}, want: "trim lower"},
+		{name: "elem", field: "tags", rules: &api.FieldRules{Elem: &api.FieldRules{Upper: proto.String("true")}}, want: "elem(upper)"},
+		{name: "default without presence", field: "code", rules: &api.FieldRules{Default: proto.String("CN-1")}, err: "only applicable to the optional scalar fields"},
+		{name: "message default", field: "main", rules: &api.FieldRules{Default: proto.String("{}")}, err: "only applicable to the optional scalar fields"},
+		{name: "invalid default", field: "ratio", rules: &api.FieldRules{Default: proto.String("half")}, err: "parse default half failed"},
+		{name: "lower and upper", field: "code", rules: &api.FieldRules{Lower: proto.String("true"), Upper: proto.String("true")}, err: "can not be both true"},
+		{name: "invalid transform", field: "code", rules: &api.FieldRules{Trim: proto.String("yes")}, err: "parse trim failed"},
+		{name: "not a string", field: "ratio", rules: &api.FieldRules{Trim: proto.String("true")}, err: "only applicable to strings"},
+		{name: "list", field: "tags", rules: &api.FieldRules{Trim: proto.String("true")}, err: "use elem instead"},
+		{name: "elem default", field: "tags", rules: &api.FieldRules{Elem: &api.FieldRules{Default: proto.String("a")}}, err: "elem: default is not applicable"},
+		{name: "map", field: "quotas", rules: &api.FieldRules{Default: proto.String("1")}, err: "use value instead"},
+		{name: "map value", field: "quotas", rules: &api.FieldRules{Value: &api.FieldRules{Trim: proto.String("true")}}, err: "value: trim, lower and upper are only applicable to strings"},
+		{name: "map key", field: "quotas", rules: &api.FieldRules{Key: &api.FieldRules{Lower: proto.String("true")}}, err: "not applicable to key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fd := request.Desc.Fields().ByName(tt.field)
+			if fd == nil {
+				t.Fatalf("no field %s in %s", tt.field, request.Desc.FullName())
+			}
+			n, err := parseNormalization(fd, tt.rules, false)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("parseNormalization() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := normalizationString(n); got != tt.want {
+				t.Errorf("parseNormalization() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNormalizationEnum(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/run.pb", "", "run.proto")
+	kind := gen.FilesByPath["run.proto"].Messages[1].Desc.Fields().ByName("kind")
+	tests := []struct {
+		value string
+		want  string
+		err   string
+	}{
+		{value: "KIND_ADMIN", want: "default=KIND_ADMIN"},
+		{value: "2", want: "default=2"},
+		{value: "KIND_ROOT", err: "enum run.Kind has no value KIND_ROOT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			n, err := parseNormalization(kind, &api.FieldRules{Default: proto.String(tt.value)}, false)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("parseNormalization() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := normalizationString(n); got != tt.want {
+				t.Errorf("parseNormalization() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
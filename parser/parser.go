@@ -33,19 +33,42 @@ func NewParser() *Parser {
 
 func (p *Parser) Parse(msg *protogen.Message) (*Validation, map[protoreflect.FieldNumber]*Validation, error) {
 	ret := make(map[protoreflect.FieldNumber]*Validation)
+	withPGV := pgvEnabled(msg)
+	var vdRules []*Rule
 	for _, f := range msg.Fields {
-		fieldAnnos := proto.GetExtension(f.Desc.Options(), api.E_Vt)
-		if proto.HasExtension(f.Desc.Options(), api.E_VtCompatible) {
-			fieldAnnos = proto.GetExtension(f.Desc.Options(), api.E_VtCompatible)
+		fieldRules, err := ResolveFieldRules(f.Desc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[annotation parser] field %s: %w", f.Desc.FullName(), err)
 		}
-		validAnnotations, err := RulesToAnnotations(fieldAnnos.(*api.FieldRules))
+		validAnnotations, err := RulesToAnnotations(fieldRules)
 		if err != nil {
 			return nil, nil, err
 		}
+		if withPGV {
+			validAnnotations = append(validAnnotations, pgvAnnotations(f.Desc)...)
+		}
+		validAnnotations = append(validAnnotations, protovalidateAnnotations(f)...)
+		vdAnnos, vdRule := vdAnnotations(msg, f)
+		validAnnotations = append(validAnnotations, vdAnnos...)
+		if vdRule != nil {
+			vdRules = append(vdRules, vdRule)
+		}
 		v, err := p.parseField(msg, f.Desc, validAnnotations, f.Desc.IsList(), f.Desc.IsMap())
 		if err != nil {
 			return nil, nil, err
 		}
+		if err = applyMessages(v, fieldRules); err != nil {
+			return nil, nil, fmt.Errorf("[annotation parser] field %s: %w", f.Desc.FullName(), err)
+		}
+		if err = applySeverity(v, fieldRules, ""); err != nil {
+			return nil, nil, fmt.Errorf("[annotation parser] field %s: %w", f.Desc.FullName(), err)
+		}
+		if err = applyWhen(msg, v, fieldRules); err != nil {
+			return nil, nil, fmt.Errorf("[annotation parser] field %s: %w", f.Desc.FullName(), err)
+		}
+		if err = applyFloatOptions(f.Desc, v, fieldRules); err != nil {
+			return nil, nil, fmt.Errorf("[annotation parser] field %s: %w", f.Desc.FullName(), err)
+		}
 		f.Desc.Number()
 		ret[f.Desc.Number()] = v
 	}
@@ -53,14 +76,32 @@ func (p *Parser) Parse(msg *protogen.Message) (*Validation, map[protoreflect.Fie
 	if proto.HasExtension(msg.Desc.Options(), api.E_MsgVtCompatible) {
 		msgAnno = proto.GetExtension(msg.Desc.Options(), api.E_MsgVtCompatible)
 	}
-	msgRule, err := RulesToAnnotations(msgAnno.(*api.FieldRules))
+	msgRules, err := ResolveRules(msg.Desc.ParentFile(), msgAnno.(*api.FieldRules))
+	if err != nil {
+		return nil, nil, fmt.Errorf("[annotation parser] message %s: %w", msg.Desc.FullName(), err)
+	}
+	msgRule, err := RulesToAnnotations(msgRules)
 	if err != nil {
 		return nil, nil, err
 	}
+	if msgRules.GetWhen() != "" {
+		return nil, nil, fmt.Errorf("[annotation parser] message %s: when is only applicable to fields", msg.Desc.FullName())
+	}
 	v, err := p.parseStruct(msg, msgRule)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[annotation parser] parse %s's annotations failed: %w", msg.Desc.Name(), err)
 	}
+	if withPGV {
+		v.Rules = append(v.Rules, pgvOneofRules(msg)...)
+	}
+	v.Rules = append(v.Rules, protovalidateRules(msg)...)
+	v.Rules = append(v.Rules, vdRules...)
+	if err = applyMessages(v, msgRules); err != nil {
+		return nil, nil, fmt.Errorf("[annotation parser] message %s: %w", msg.Desc.FullName(), err)
+	}
+	if err = applySeverity(v, msgRules, ""); err != nil {
+		return nil, nil, fmt.Errorf("[annotation parser] message %s: %w", msg.Desc.FullName(), err)
+	}
 
 	return v, ret, nil
 }
@@ -128,7 +169,7 @@ func (p *Parser) parseField(msg *protogen.Message, field protoreflect.FieldDescr
 
 func (p *Parser) parseInt(msg *protogen.Message, annotations []*Annotation) (*Validation, error) {
 	validation := &Validation{ValidationType: NumericValidation}
-	rf := NewRuleFactory(NumericKeys)
+	rf := NewRuleFactory(IntKeys)
 	for _, anno := range annotations {
 		annoKey, annoVals := anno.Key, anno.Values
 		kp, err := newKeyParser(annoKey)
@@ -159,11 +200,15 @@ func (p *Parser) parseInt(msg *protogen.Message, annotations []*Annotation) (*Va
 					GreatThan,
 					GreatEqual,
 					In,
-					NotIn:
+					NotIn,
+					MultipleOf:
 					val, err := strconv.ParseInt(annoVal, 0, 64)
 					if err != nil {
 						return nil, fmt.Errorf("parse int value failed: %w", err)
 					}
+					if nodeKey == MultipleOf && val <= 0 {
+						return nil, fmt.Errorf("multiple_of must be positive")
+					}
 					value = &ValidationValue{
 						ValueType:  IntValue,
 						TypedValue: TypedValidationValue{Int: val},
@@ -181,6 +226,9 @@ func (p *Parser) parseInt(msg *protogen.Message, annotations []*Annotation) (*Va
 					return nil, fmt.Errorf("unrecognized numeric annotation key %s", annoKey)
 				}
 			}
+			if nodeKey == MultipleOf && value.ValueType != IntValue {
+				return nil, fmt.Errorf("multiple_of must be an integer")
+			}
 			exist, rule := rf.NewRule(nodeKey, value)
 			if !exist {
 				return nil, fmt.Errorf("unrecognized numeric annotation key %s", annoKey)
@@ -243,7 +291,7 @@ func (p *Parser) parseBool(msg *protogen.Message, annotations []*Annotation) (*V
 
 func (p *Parser) parseDouble(msg *protogen.Message, annotations []*Annotation) (*Validation, error) {
 	validation := &Validation{ValidationType: NumericValidation}
-	rf := NewRuleFactory(NumericKeys)
+	rf := NewRuleFactory(DoubleKeys)
 	for _, anno := range annotations {
 		annoKey, annoVals := anno.Key, anno.Values
 		kp, err := newKeyParser(annoKey)
@@ -283,7 +331,7 @@ func (p *Parser) parseDouble(msg *protogen.Message, annotations []*Annotation) (
 						ValueType:  DoubleValue,
 						TypedValue: TypedValidationValue{Double: val},
 					}
-				case NotNil:
+				case NotNil, Finite:
 					val, err := strconv.ParseBool(annoVal)
 					if err != nil {
 						return nil, fmt.Errorf("parse int value failed: %w", err)
@@ -619,7 +667,7 @@ func (p *Parser) parseEnum(msg *protogen.Message, annotations []*Annotation) (*V
 			}
 			if value == nil {
 				switch nodeKey {
-				case Const:
+				case Const, In, NotIn:
 					value = &ValidationValue{
 						ValueType:  BinaryValue,
 						TypedValue: TypedValidationValue{Binary: annoVal},
@@ -0,0 +1,201 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parse parses the messages of the file in the descriptor set, the rules are
+// returned by the names of the messages and <message>.<field> for the fields.
+func parse(t *testing.T, set, file string) map[string][]string {
+	t.Helper()
+	gen := plugintest.New(t, set, "", file)
+	ret := make(map[string][]string)
+	var walk func(msgs []*protogen.Message)
+	walk = func(msgs []*protogen.Message) {
+		for _, msg := range msgs {
+			if msg.Desc.IsMapEntry() {
+				continue
+			}
+			v, fields, err := NewParser().Parse(msg)
+			if err != nil {
+				t.Fatalf("parse %s failed: %v", msg.Desc.FullName(), err)
+			}
+			name := string(msg.Desc.Name())
+			ret[name] = formatRules("", v)
+			for _, f := range msg.Fields {
+				ret[name+"."+string(f.Desc.Name())] = formatRules("", fields[f.Desc.Number()])
+			}
+			walk(msg.Messages)
+		}
+	}
+	for _, f := range gen.Files {
+		if f.Generate {
+			walk(f.Messages)
+		}
+	}
+	return ret
+}
+
+// formatRules formats the rules as key=value, the keys of the inner rules are
+// prefixed by the elem, key or value rule.
+func formatRules(prefix string, v *Validation) []string {
+	var ret []string
+	for _, r := range v.Rules {
+		key := prefix + KeyString[r.Key]
+		if r.Inner != nil {
+			ret = append(ret, formatRules(key+".", r.Inner)...)
+			continue
+		}
+		ret = append(ret, key+"="+formatValue(r))
+	}
+	return ret
+}
+
+func formatValue(r *Rule) string {
+	if r.Specified != nil {
+		return r.Specified.String()
+	}
+	vals := make([]string, 0, len(r.Range))
+	for _, v := range r.Range {
+		vals = append(vals, v.String())
+	}
+	return "[" + strings.Join(vals, " ") + "]"
+}
+
+// checkRules compares the parsed rules with the wanted ones of the names.
+func checkRules(t *testing.T, got map[string][]string, want map[string][]string) {
+	t.Helper()
+	for name, w := range want {
+		g, ok := got[name]
+		if !ok {
+			t.Errorf("%s is not parsed", name)
+			continue
+		}
+		if len(g) == 0 && len(w) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(g, w) {
+			t.Errorf("rules of %s = %q, want %q", name, g, w)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	got := parse(t, "../testdata/vt.pb", "vt.proto")
+	checkRules(t, got, map[string][]string{
+		"Item.name":        {"max_size=16", "min_size=1", "pattern=^[a-z]+$"},
+		"Item.count":       {"gt=0", "le=100"},
+		"Request":          {"assert=@equal(@mod($max, 2), 1)"},
+		"Request.page":     {"ge=1", "lt=1000"},
+		"Request.ratio":    {"gt=0", "le=1", "not_nil=true"},
+		"Request.enabled":  {"const=true"},
+		"Request.code":     {"not_contains= ", "not_in=[CN-000]", "prefix=CN-"},
+		"Request.token":    {"max_size=32", "min_size=4"},
+		"Request.status":   {"defined_only=true"},
+		"Request.tags":     {"max_size=3", "min_size=1", "elem.in=[a b c]"},
+		"Request.items":    {"max_size=10", "elem.skip=false"},
+		"Request.quotas":   {"key.min_size=1", "value.ge=0"},
+		"Request.slots":    {"no_sparse=true"},
+		"Request.main":     {"not_nil=true"},
+		"Request.extra":    {"skip=true"},
+		"Request.max":      {"ge=$page"},
+		"Request.deadline": {"gt=@now_unix_nano()"},
+		"Measure.value":    {"finite=true", "ge=-100", "le=100"},
+		"Measure.ratio":    {"const=0.3"},
+		"Measure.score":    {"lt=10"},
+		"Measure.step":     {"multiple_of=5"},
+		"Measure.weights":  {"elem.finite=true", "elem.gt=0"},
+	})
+}
+
+func TestParseMultipleOf(t *testing.T) {
+	tests := []struct {
+		value string
+		err   string
+	}{
+		{value: "3"},
+		{value: "0", err: "multiple_of must be positive"},
+		{value: "-2", err: "multiple_of must be positive"},
+		{value: "1.5", err: "parse int value failed"},
+		{value: "$page", err: "multiple_of must be an integer"},
+	}
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	request := gen.FilesByPath["vt.proto"].Messages[1]
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := NewParser().parseInt(request, []*Annotation{{Key: "vt.multiple_of", Values: []string{tt.value}}})
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if !strings.Contains(got, tt.err) || (tt.err == "") != (err == nil) {
+				t.Errorf("parseInt() error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestNumericKeys(t *testing.T) {
+	union := map[Key]bool{}
+	for _, k := range append(append([]Key{}, IntKeys...), DoubleKeys...) {
+		union[k] = true
+	}
+	got := map[Key]bool{}
+	for _, k := range NumericKeys {
+		got[k] = true
+	}
+	if !reflect.DeepEqual(got, union) {
+		t.Errorf("NumericKeys = %v, want the union of IntKeys and DoubleKeys", NumericKeys)
+	}
+}
+
+func TestParseMessages(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vt.pb", "", "vt.proto")
+	file := gen.FilesByPath["vt.proto"]
+	messages := func(msg *protogen.Message, field string) map[string]string {
+		_, fields, err := NewParser().Parse(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ret := make(map[string]string)
+		var walk func(prefix string, v *Validation)
+		walk = func(prefix string, v *Validation) {
+			for _, r := range v.Rules {
+				if r.Inner != nil {
+					walk(prefix+KeyString[r.Key]+".", r.Inner)
+				} else if r.Message != "" {
+					ret[prefix+KeyString[r.Key]] = r.Message
+				}
+			}
+		}
+		walk("", fields[msg.Desc.Fields().ByName(protoreflect.Name(field)).Number()])
+		return ret
+	}
+	item, request := file.Messages[0], file.Messages[1]
+	if got, want := messages(item, "count"), map[string]string{"gt": "{field} must be greater than {constraint}, got {value}"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("messages of count = %q, want %q", got, want)
+	}
+	if got, want := messages(request, "tags"), map[string]string{"elem.in": "tag {value} is not allowed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("messages of tags = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"github.com/cloudwego/protoc-gen-validator/parser/pgv"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// pgvEnabled reports whether the protoc-gen-validate rules of msg are in effect,
+// (validate.disabled) and (validate.ignored) turn them off.
+func pgvEnabled(msg *protogen.Message) bool {
+	opts := msg.Desc.Options()
+	return !proto.GetExtension(opts, pgv.E_Disabled).(bool) && !proto.GetExtension(opts, pgv.E_Ignored).(bool)
+}
+
+// pgvOneofRules translates (validate.required) of the oneofs to struct-like rules.
+func pgvOneofRules(msg *protogen.Message) []*Rule {
+	var ret []*Rule
+	for _, oneof := range msg.Oneofs {
+		if oneof.Desc.IsSynthetic() || !proto.GetExtension(oneof.Desc.Options(), pgv.E_Required).(bool) {
+			continue
+		}
+		ret = append(ret, oneofRequiredRule(oneof))
+	}
+	return ret
+}
+
+// pgvAnnotations translates the (validate.rules) of a field to annotations, the
+// rules that can not be expressed are logged and dropped.
+func pgvAnnotations(field protoreflect.FieldDescriptor) []*Annotation {
+	return TranslatePGV(field, nil)
+}
+
+// TranslatePGV translates the (validate.rules) of a field to annotations, the
+// rules that can not be expressed are passed to report, or logged if it's nil.
+func TranslatePGV(field protoreflect.FieldDescriptor, report func(rule, reason string)) []*Annotation {
+	if !proto.HasExtension(field.Options(), pgv.E_Rules) {
+		return nil
+	}
+	t := &ruleTranslator{source: "pgv", field: field, report: report}
+	t.translate(validatorPrefix+".", field, proto.GetExtension(field.Options(), pgv.E_Rules).(*pgv.FieldRules).ProtoReflect())
+	return t.annotations
+}
+
+func oneofRequiredRule(oneof *protogen.Oneof) *Rule {
+	return &Rule{
+		Key: OneofRequired,
+		Specified: &ValidationValue{
+			ValueType:  BinaryValue,
+			TypedValue: TypedValidationValue{Binary: string(oneof.Desc.Name())},
+		},
+	}
+}
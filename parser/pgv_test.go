@@ -0,0 +1,73 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestPGV(t *testing.T) {
+	got := parse(t, "../testdata/pgv.pb", "pgv.proto")
+	checkRules(t, got, map[string][]string{
+		"Item":          {"oneof_required=kind"},
+		"Item.name":     {"suffix=y", "pattern=^(?s:.){1,10}$", "prefix=x"},
+		"Item.count":    {"gt=0", "le=100"},
+		"Item.outside":  nil,
+		"Item.ratio":    {"in=[1.5 2.5]"},
+		"Item.weight":   {"const=0.5"},
+		"Item.data":     {"min_size=2", "max_size=8"},
+		"Item.color":    {"const=Color.COLOR_RED", "defined_only=true"},
+		"Item.tags":     {"min_size=1", "max_size=4", "elem.pattern=^(?s:.){3}$"},
+		"Item.children": {"max_size=4", "no_sparse=true", "key.pattern=^(?s:.){1,}$"},
+		"Item.parent":   {"skip=true"},
+		"Item.id":       {"pattern=^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"},
+		"Item.email":    nil,
+		"Item.ttl":      {"not_nil=true"},
+		"Item.header":   {"pattern=^:?[0-9a-zA-Z!#$%&'*+-.^_|~`]+$"},
+		// the rules of protoc-gen-validate are turned off, the vt rules are kept
+		"Disabled.name": {"max_size=4"},
+	})
+}
+
+func TestTranslatePGV(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/pgv.pb", "", "pgv.proto")
+	msg := gen.FilesByPath["pgv.proto"].Messages[0]
+	tests := []struct {
+		field   string
+		ignored []string
+	}{
+		{"name", nil},
+		{"outside", []string{"gt/lt"}},
+		{"tags", []string{"unique"}},
+		{"email", []string{"email"}},
+		{"ttl", []string{"duration.gt"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.field, func(t *testing.T) {
+			fd := msg.Desc.Fields().ByName(protoreflect.Name(tt.field))
+			var ignored []string
+			TranslatePGV(fd, func(rule, reason string) {
+				ignored = append(ignored, rule)
+			})
+			if !reflect.DeepEqual(ignored, tt.ignored) {
+				t.Errorf("ignored rules of %s = %q, want %q", tt.field, ignored, tt.ignored)
+			}
+		})
+	}
+}
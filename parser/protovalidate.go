@@ -0,0 +1,163 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"log"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/protovalidate"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const protovalidateSource = "protovalidate"
+
+func protovalidateFieldRules(field *protogen.Field) *protovalidate.FieldRules {
+	if !proto.HasExtension(field.Desc.Options(), protovalidate.E_Field) {
+		return nil
+	}
+	rules := proto.GetExtension(field.Desc.Options(), protovalidate.E_Field).(*protovalidate.FieldRules)
+	if rules.GetIgnore() == protovalidate.Ignore_IGNORE_ALWAYS {
+		return nil
+	}
+	return rules
+}
+
+// protovalidateAnnotations translates the (buf.validate.field) of a field to
+// annotations, the rules that can not be expressed are logged and dropped. The
+// cel rules are compiled by protovalidateRules.
+func protovalidateAnnotations(field *protogen.Field) []*Annotation {
+	rules := protovalidateFieldRules(field)
+	if rules == nil {
+		return nil
+	}
+	t := &ruleTranslator{source: protovalidateSource, field: field.Desc}
+	if rules.GetIgnore() == protovalidate.Ignore_IGNORE_IF_ZERO_VALUE {
+		t.unsupported("ignore", "the rules are applied to the zero value too")
+	}
+	prefix := validatorPrefix + "."
+	if rules.GetRequired() {
+		t.required(prefix, field.Desc)
+	}
+	t.translate(prefix, field.Desc, rules.ProtoReflect())
+	return t.annotations
+}
+
+// required translates the required rule of protovalidate, which requires the
+// fields with presence to be set and the others to be non-zero.
+func (t *ruleTranslator) required(prefix string, fd protoreflect.FieldDescriptor) {
+	switch {
+	case fd.IsList() || fd.IsMap():
+		t.add(prefix, MinSize, "1")
+	case fd.HasPresence():
+		t.notNil(prefix, "required", fd)
+	case fd.Kind() == protoreflect.StringKind || fd.Kind() == protoreflect.BytesKind:
+		t.add(prefix, MinSize, "1")
+	case fd.Kind() == protoreflect.BoolKind:
+		t.add(prefix, Const, "true")
+	case fd.Kind() == protoreflect.EnumKind:
+		// the enums without a zero value are never zero
+		if zero := fd.Enum().Values().ByNumber(0); zero != nil {
+			t.add(prefix, NotIn, enumValueName(fd.Enum(), zero))
+		}
+	default:
+		t.add(prefix, NotIn, "0")
+	}
+}
+
+// protovalidateRules translates the cel rules of the fields and the message, and
+// the oneof rules, to struct-like rules.
+func protovalidateRules(msg *protogen.Message) []*Rule {
+	var ret []*Rule
+	for _, f := range msg.Fields {
+		rules := protovalidateFieldRules(f)
+		for _, rule := range rules.GetCel() {
+			if assert := compileRule(msg, f, rule); assert != nil {
+				ret = append(ret, assert)
+			}
+		}
+	}
+	msgRules := proto.GetExtension(msg.Desc.Options(), protovalidate.E_Message).(*protovalidate.MessageRules)
+	for _, rule := range msgRules.GetCel() {
+		if assert := compileRule(msg, nil, rule); assert != nil {
+			ret = append(ret, assert)
+		}
+	}
+	for _, oneof := range msgRules.GetOneof() {
+		if assert := messageOneofRule(msg, oneof); assert != nil {
+			ret = append(ret, assert)
+		}
+	}
+	for _, oneof := range msg.Oneofs {
+		if oneof.Desc.IsSynthetic() || !proto.HasExtension(oneof.Desc.Options(), protovalidate.E_Oneof) {
+			continue
+		}
+		if proto.GetExtension(oneof.Desc.Options(), protovalidate.E_Oneof).(*protovalidate.OneofRules).GetRequired() {
+			ret = append(ret, oneofRequiredRule(oneof))
+		}
+	}
+	return ret
+}
+
+func compileRule(msg *protogen.Message, field *protogen.Field, rule *protovalidate.Rule) *Rule {
+	f, err := CompileCEL(msg, field, rule.GetExpression())
+	if err != nil {
+		name := msg.Desc.FullName()
+		if field != nil {
+			name = field.Desc.FullName()
+		}
+		log.Printf("[%s] %s: cel rule %s is ignored, %s\n", protovalidateSource, name, rule.GetId(), err)
+		return nil
+	}
+	if field != nil && field.Desc.HasPresence() {
+		// the rules are only applied to the fields set
+		unset := call("not", functionValue(call("has", fieldExpr(field).value)))
+		f = call("or", functionValue(unset), functionValue(f))
+	}
+	return &Rule{
+		Key:       Assert,
+		Specified: &ValidationValue{ValueType: FunctionValue, TypedValue: TypedValidationValue{Function: f}},
+		Message:   rule.GetMessage(),
+	}
+}
+
+// messageOneofRule asserts that at most one of the fields is set, or exactly one
+// if required.
+func messageOneofRule(msg *protogen.Message, oneof *protovalidate.MessageOneofRule) *Rule {
+	var fields []ValidationValue
+	for _, name := range oneof.GetFields() {
+		c := &celCompiler{msg: msg}
+		f := c.fieldByName(name)
+		if f == nil {
+			log.Printf("[%s] %s: oneof rule is ignored, no field %s\n", protovalidateSource, msg.Desc.FullName(), name)
+			return nil
+		}
+		fields = append(fields, functionValue(call("has", fieldExpr(f).value)))
+	}
+	var args []ValidationValue
+	for i := range fields {
+		for j := i + 1; j < len(fields); j++ {
+			args = append(args, functionValue(call("not", functionValue(call("and", fields[i], fields[j])))))
+		}
+	}
+	if oneof.GetRequired() {
+		args = append(args, functionValue(call("or", fields...)))
+	}
+	if len(args) == 0 {
+		return nil
+	}
+	return &Rule{Key: Assert, Specified: &ValidationValue{ValueType: FunctionValue, TypedValue: TypedValidationValue{Function: call("and", args...)}}}
+}
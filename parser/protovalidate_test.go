@@ -0,0 +1,93 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestProtovalidate(t *testing.T) {
+	got := parse(t, "../testdata/protovalidate.pb", "protovalidate.proto")
+	checkRules(t, got, map[string][]string{
+		"User": {
+			"assert=@lt($max, 100)",
+			`assert=@or(@not(@has($nick)), @and(@le(@size($nick), 4), @ne($nick, "root")))`,
+			"assert=@and(@le($min, $max), @or(@gt(@size($tags), 0), @not(@has($nick))))",
+			"assert=@and(@not(@and(@has($email), @has($phone))), @or(@has($email), @has($phone)))",
+			"oneof_required=choice",
+		},
+		"User.name":  {"prefix=u", "pattern=^(?s:.){2,8}$"},
+		"User.tags":  {"max_size=3", "elem.pattern=^(?s:.){1,}$"},
+		"User.kind":  {"defined_only=true", "in=[Kind.KIND_A]"},
+		"User.score": {"ge=0", "lt=1"},
+		"User.id":    {"min_size=1", "pattern=^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"},
+		"User.skip":  nil,
+		"User.bad":   nil,
+	})
+}
+
+func TestCompileCEL(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/protovalidate.pb", "", "protovalidate.proto")
+	var user *protogen.Message
+	for _, f := range gen.Files {
+		if f.Generate {
+			user = f.Messages[0]
+		}
+	}
+	fields := make(map[string]*protogen.Field)
+	for _, f := range user.Fields {
+		fields[string(f.Desc.Name())] = f
+	}
+	tests := []struct {
+		field string
+		expr  string
+		want  string
+		err   string
+	}{
+		{expr: "this.min <= this.max", want: "@le($min, $max)"},
+		{expr: "this.min < 1 || this.min > 10 && this.max != 0", want: "@or(@lt($min, 1), @and(@gt($min, 10), @ne($max, 0)))"},
+		{expr: "!(this.name == 'x')", want: `@not(@equal($name, "x"))`},
+		{expr: "size(this.tags) > 0", want: "@gt(@size($tags), 0)"},
+		{expr: "this.tags.size() > 0", want: "@gt(@size($tags), 0)"},
+		{expr: "has(this.nick)", want: "@has($nick)"},
+		{field: "score", expr: "this >= 0.5", want: "@ge($score, 0.5)"},
+		{field: "name", expr: "this", err: "the expression is a string instead of a bool"},
+		{expr: "this.min + 1 > 2", err: "at column"},
+		{expr: "this.nothing > 1", err: "nothing"},
+		{field: "max", expr: "this > 1.5", err: "can not compare int with double"},
+		{field: "name", expr: "this.startsWith('x')", err: "function startsWith is not supported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			f, err := CompileCEL(user, fields[tt.field], tt.expr)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("CompileCEL() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CompileCEL() failed: %v", err)
+			}
+			if got := f.String(); got != tt.want {
+				t.Errorf("CompileCEL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
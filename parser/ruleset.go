@@ -0,0 +1,155 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ResolveRules returns the rules with the rule sets referred by use expanded, the
+// rules of the field take precedence over the ones of the rule sets. The rule sets
+// are looked up in file and the files it imports, an unqualified name is in the
+// package of file.
+func ResolveRules(file protoreflect.FileDescriptor, rules *api.FieldRules) (*api.FieldRules, error) {
+	return resolveRules(file, rules, nil)
+}
+
+func resolveRules(file protoreflect.FileDescriptor, rules *api.FieldRules, seen []string) (*api.FieldRules, error) {
+	if rules == nil {
+		return nil, nil
+	}
+	ret := proto.Clone(rules).(*api.FieldRules)
+	if ret.Use != nil {
+		set, setFile, name, err := lookupRuleSet(file, ret.GetUse())
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range seen {
+			if s == name {
+				return nil, fmt.Errorf("rule set %s uses itself", name)
+			}
+		}
+		base, err := resolveRules(setFile, set.GetRules(), append(seen, name))
+		if err != nil {
+			return nil, fmt.Errorf("rule set %s: %w", name, err)
+		}
+		if base == nil {
+			base = &api.FieldRules{}
+		}
+		ret.Use = nil
+		mergeRules(base, ret)
+		ret = base
+	}
+	// the rules of the key, value and elem may use rule sets as well
+	var err error
+	if ret.Key, err = resolveRules(file, ret.Key, seen); err != nil {
+		return nil, fmt.Errorf("key: %w", err)
+	}
+	if ret.Value, err = resolveRules(file, ret.Value, seen); err != nil {
+		return nil, fmt.Errorf("value: %w", err)
+	}
+	if ret.Elem, err = resolveRules(file, ret.Elem, seen); err != nil {
+		return nil, fmt.Errorf("elem: %w", err)
+	}
+	return ret, nil
+}
+
+// mergeRules sets the rules of src to dst, the rules of the key, value and elem
+// and the msgs are merged, and the others are replaced.
+func mergeRules(dst, src *api.FieldRules) {
+	d := dst.ProtoReflect()
+	src.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			m := d.Mutable(fd).Map()
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				m.Set(k, v)
+				return true
+			})
+		case fd.Message() != nil && !fd.IsList() && d.Has(fd):
+			mergeRules(d.Get(fd).Message().Interface().(*api.FieldRules), v.Message().Interface().(*api.FieldRules))
+		default:
+			d.Set(fd, v)
+		}
+		return true
+	})
+}
+
+// lookupRuleSet finds the rule set of a name in file and its imports, and returns
+// it with the file defining it and its full name.
+func lookupRuleSet(file protoreflect.FileDescriptor, name string) (*api.RuleSet, protoreflect.FileDescriptor, string, error) {
+	var candidates []string
+	if file.Package() != "" {
+		candidates = append(candidates, string(file.Package())+"."+name)
+	}
+	candidates = append(candidates, name)
+	for _, c := range candidates {
+		var (
+			found     *api.RuleSet
+			foundFile protoreflect.FileDescriptor
+		)
+		for _, f := range visibleFiles(file) {
+			for _, set := range proto.GetExtension(f.Options(), api.E_RuleSets).([]*api.RuleSet) {
+				if ruleSetName(f, set) != c {
+					continue
+				}
+				if found != nil && foundFile.Path() == f.Path() {
+					return nil, nil, "", fmt.Errorf("rule set %s is defined twice in %s", c, f.Path())
+				}
+				if found != nil {
+					return nil, nil, "", fmt.Errorf("rule set %s is defined in both %s and %s", c, foundFile.Path(), f.Path())
+				}
+				found, foundFile = set, f
+			}
+		}
+		if found != nil {
+			return found, foundFile, c, nil
+		}
+	}
+	return nil, nil, "", fmt.Errorf("rule set %s not found", name)
+}
+
+func ruleSetName(f protoreflect.FileDescriptor, set *api.RuleSet) string {
+	if f.Package() == "" {
+		return set.GetName()
+	}
+	return string(f.Package()) + "." + set.GetName()
+}
+
+// visibleFiles returns file, the files it imports and the ones imported publicly
+// by them, like the scope of the types in protobuf.
+func visibleFiles(file protoreflect.FileDescriptor) []protoreflect.FileDescriptor {
+	ret := []protoreflect.FileDescriptor{file}
+	visited := map[string]bool{file.Path(): true}
+	var walk func(f protoreflect.FileDescriptor, publicOnly bool)
+	walk = func(f protoreflect.FileDescriptor, publicOnly bool) {
+		imports := f.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			imp := imports.Get(i)
+			if (publicOnly && !imp.IsPublic) || visited[imp.Path()] {
+				continue
+			}
+			visited[imp.Path()] = true
+			ret = append(ret, imp.FileDescriptor)
+			walk(imp.FileDescriptor, true)
+		}
+	}
+	walk(file, false)
+	return ret
+}
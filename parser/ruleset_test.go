@@ -0,0 +1,128 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestParseRuleSets(t *testing.T) {
+	got := parse(t, "../testdata/ruleset.pb", "ruleset.proto")
+	checkRules(t, got, map[string][]string{
+		"Account.username": {"max_size=16", "min_size=3", "pattern=^[a-z0-9_]+$"},
+		"Account.nickname": {"max_size=32", "min_size=3", "pattern=^[a-z0-9_]+$"},
+		"Account.tags":     {"max_size=5", "elem.max_size=8", "elem.min_size=1"},
+	})
+}
+
+func TestResolveRules(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/ruleset.pb", "", "ruleset.proto")
+	file := gen.FilesByPath["ruleset.proto"].Desc
+	common := gen.FilesByPath["common.proto"].Desc
+	tests := []struct {
+		name  string
+		file  bool // resolve in common.proto instead of ruleset.proto
+		rules *api.FieldRules
+		want  string
+		err   string
+	}{
+		{
+			name:  "none",
+			rules: &api.FieldRules{MinSize: proto.String("1")},
+			want:  `min_size:"1"`,
+		},
+		{
+			name:  "own package",
+			rules: &api.FieldRules{Use: proto.String("tag")},
+			want:  `min_size:"1" max_size:"8"`,
+		},
+		{
+			name:  "qualified",
+			rules: &api.FieldRules{Use: proto.String("ruleset.tag"), MinSize: proto.String("2")},
+			want:  `min_size:"2" max_size:"8"`,
+		},
+		{
+			name:  "imported",
+			rules: &api.FieldRules{Use: proto.String("common.username"), Msgs: map[string]string{"min_size": "too short"}},
+			want:  `min_size:"3" max_size:"16" pattern:"^[a-z0-9_]+$" msgs:{key:"min_size" value:"too short"} msgs:{key:"pattern" value:"{field} is not a user name"}`,
+		},
+		{
+			name:  "chained",
+			file:  true,
+			rules: &api.FieldRules{Use: proto.String("handle")},
+			want:  `min_size:"3" max_size:"16" pattern:"^[a-z0-9_]+$" prefix:"@" msgs:{key:"pattern" value:"{field} is not a user name"}`,
+		},
+		{
+			name:  "elem",
+			rules: &api.FieldRules{Elem: &api.FieldRules{Use: proto.String("tag"), MaxSize: proto.String("4")}},
+			want:  `elem:{min_size:"1" max_size:"4"}`,
+		},
+		{
+			name:  "not imported",
+			file:  true,
+			rules: &api.FieldRules{Use: proto.String("ruleset.tag")},
+			err:   "rule set ruleset.tag not found",
+		},
+		{
+			name:  "not found",
+			rules: &api.FieldRules{Value: &api.FieldRules{Use: proto.String("nothing")}},
+			err:   "value: rule set nothing not found",
+		},
+		{
+			name:  "defined twice",
+			rules: &api.FieldRules{Use: proto.String("dup")},
+			err:   "rule set ruleset.dup is defined twice in ruleset.proto",
+		},
+		{
+			name:  "cycle",
+			rules: &api.FieldRules{Use: proto.String("common.loop")},
+			err:   "rule set common.loop: rule set common.loop2: rule set common.loop uses itself",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := file
+			if tt.file {
+				f = common
+			}
+			before := proto.Clone(tt.rules)
+			got, err := ResolveRules(f, tt.rules)
+			if !proto.Equal(tt.rules, before) {
+				t.Errorf("ResolveRules() modified the rules to {%v}", tt.rules)
+			}
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("ResolveRules() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := &api.FieldRules{}
+			if err := prototext.Unmarshal([]byte(tt.want), want); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("ResolveRules() = {%v}, want {%v}", got, want)
+			}
+		})
+	}
+}
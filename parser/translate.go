@@ -0,0 +1,441 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxRepeat is the largest count of a repetition accepted by go regexp.
+const maxRepeat = 1000
+
+const (
+	uuidPattern             = "^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$"
+	trimmedUUIDPattern      = "^[0-9a-fA-F]{32}$"
+	headerNamePattern       = "^:?[0-9a-zA-Z!#$%&'*+-.^_|~\x60]+$"
+	headerValuePattern      = `^[^\x00-\x08\x0A-\x1F\x7F]*$`
+	looseHeaderValuePattern = `^[^\x00\x0A\x0D]*$`
+)
+
+// ruleTranslator translates the field rules of protoc-gen-validate and
+// protovalidate to annotations. Both share the names of the rules, so the rules
+// are read by reflection. The rules that can not be expressed are logged and
+// dropped.
+type ruleTranslator struct {
+	source      string // the name of the annotation style, used in the logs
+	field       protoreflect.FieldDescriptor
+	annotations []*Annotation
+	// report receives the rules that can not be expressed instead of the log
+	report func(rule, reason string)
+}
+
+func (t *ruleTranslator) add(prefix string, key Key, values ...string) {
+	for _, val := range values {
+		if strings.HasPrefix(val, "$") || strings.HasPrefix(val, "@") {
+			t.unsupported(KeyString[key], fmt.Sprintf("the value %q would be read as a field reference or a function", val))
+			return
+		}
+	}
+	t.annotations = append(t.annotations, &Annotation{Key: prefix + KeyString[key], Values: values})
+}
+
+func (t *ruleTranslator) unsupported(rule, reason string) {
+	if t.report != nil {
+		t.report(rule, reason)
+		return
+	}
+	log.Printf("[%s] %s: rule %s is ignored, %s\n", t.source, t.field.FullName(), rule, reason)
+}
+
+// notNil adds not_nil if the field can be checked against nil.
+func (t *ruleTranslator) notNil(prefix, rule string, fd protoreflect.FieldDescriptor) {
+	if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+		t.unsupported(rule, "the fields of a oneof can not be checked against nil, require the oneof instead")
+		return
+	}
+	t.add(prefix, NotNil, "true")
+}
+
+// translate adds the rules of r, a FieldRules message. fd is the field, or the
+// key or value field of a map, the rules are applied to.
+func (t *ruleTranslator) translate(prefix string, fd protoreflect.FieldDescriptor, r protoreflect.Message) {
+	rules := ruleMessage{r}
+	if cel := rules.field("cel"); cel != nil && prefix != validatorPrefix+"." && r.Get(cel).List().Len() > 0 {
+		// protovalidate only
+		t.unsupported("cel", "cel rules of the elements, keys and values can not be compiled")
+	}
+	// protoc-gen-validate only
+	if msg, ok := rules.message("message"); ok {
+		if msg.bool("skip") {
+			t.add(prefix, Skip, "true")
+		}
+		if msg.bool("required") {
+			t.notNil(prefix, "message.required", fd)
+		}
+	}
+	oneof := r.Descriptor().Oneofs().ByName("type")
+	if oneof == nil {
+		return
+	}
+	typ := r.WhichOneof(oneof)
+	if typ == nil {
+		return
+	}
+	m := ruleMessage{r.Get(typ).Message()}
+	switch name := typ.Name(); name {
+	case "float", "double", "int32", "int64", "uint32", "uint64", "sint32", "sint64",
+		"fixed32", "fixed64", "sfixed32", "sfixed64":
+		t.numeric(prefix, m)
+	case "bool":
+		if m.has("const") {
+			t.add(prefix, Const, m.format("const"))
+		}
+	case "string":
+		t.string(prefix, m)
+	case "bytes":
+		t.bytes(prefix, m)
+	case "enum":
+		t.enum(prefix, fd, m)
+	case "repeated":
+		t.repeated(prefix, fd, m)
+	case "map":
+		t.mapRules(prefix, fd, m)
+	default:
+		// any, duration and timestamp
+		m.Range(func(rule protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			switch rule.Name() {
+			case "required":
+				t.notNil(prefix, string(name)+".required", fd)
+			case "example":
+			default:
+				t.unsupported(string(name)+"."+string(rule.Name()), "message fields only support skip and not_nil")
+			}
+			return true
+		})
+	}
+}
+
+// numeric translates the rules shared by all the numeric types.
+func (t *ruleTranslator) numeric(prefix string, m ruleMessage) {
+	if m.has("const") {
+		t.add(prefix, Const, m.format("const"))
+	}
+	var lowerKey, upperKey Key
+	var lower, upper string
+	if m.has("gt") {
+		lowerKey, lower = GreatThan, "gt"
+	} else if m.has("gte") {
+		lowerKey, lower = GreatEqual, "gte"
+	}
+	if m.has("lt") {
+		upperKey, upper = LessThan, "lt"
+	} else if m.has("lte") {
+		upperKey, upper = LessEqual, "lte"
+	}
+	if lower != "" && upper != "" && m.float(upper) < m.float(lower) {
+		// an upper bound below the lower bound is read as an exclusive range
+		t.unsupported(lower+"/"+upper, "exclusive ranges can not be expressed")
+		lower, upper = "", ""
+	}
+	if lower != "" {
+		t.add(prefix, lowerKey, m.format(lower))
+	}
+	if upper != "" {
+		t.add(prefix, upperKey, m.format(upper))
+	}
+	t.lists(prefix, m)
+	if m.bool("finite") {
+		t.add(prefix, Finite, "true")
+	}
+	if m.bool("ignore_empty") {
+		t.unsupported("ignore_empty", "the rules are applied to the zero value too")
+	}
+}
+
+// lists adds in and not_in.
+func (t *ruleTranslator) lists(prefix string, m ruleMessage) {
+	for _, key := range []Key{In, NotIn} {
+		if values := m.list(KeyString[key]); len(values) > 0 {
+			t.add(prefix, key, values...)
+		}
+	}
+}
+
+func (t *ruleTranslator) string(prefix string, m ruleMessage) {
+	if m.has("const") {
+		t.add(prefix, Const, m.format("const"))
+	}
+	// the length is counted in runes, go regexp matches a rune with (?s:.)
+	minLen, maxLen := "min_len", "max_len"
+	if m.has("len") {
+		minLen, maxLen = "len", "len"
+	}
+	if m.has(minLen) || m.has(maxLen) {
+		lo, hi := "0", ""
+		if m.has(minLen) {
+			lo = m.format(minLen)
+		}
+		if m.has(maxLen) {
+			hi = m.format(maxLen)
+		}
+		switch {
+		case m.float(minLen) > maxRepeat, m.float(maxLen) > maxRepeat:
+			t.unsupported("len/min_len/max_len", "the length is counted in bytes instead of runes since it's too large for a pattern")
+			if m.has(minLen) {
+				t.add(prefix, MinSize, lo)
+			}
+			if m.has(maxLen) {
+				t.add(prefix, MaxSize, hi)
+			}
+		case minLen == maxLen:
+			t.add(prefix, Pattern, fmt.Sprintf("^(?s:.){%s}$", lo))
+		default:
+			t.add(prefix, Pattern, fmt.Sprintf("^(?s:.){%s,%s}$", lo, hi))
+		}
+	}
+	t.sizes(prefix, m, "len_bytes", "min_bytes", "max_bytes")
+	t.binary(prefix, m)
+	switch wk := m.which("well_known"); wk {
+	case "":
+	case "uuid":
+		if m.bool(wk) {
+			t.add(prefix, Pattern, uuidPattern)
+		}
+	case "tuuid":
+		if m.bool(wk) {
+			t.add(prefix, Pattern, trimmedUUIDPattern)
+		}
+	case "well_known_regex":
+		switch m.format(wk) {
+		case "HTTP_HEADER_NAME":
+			t.add(prefix, Pattern, headerNamePattern)
+		case "HTTP_HEADER_VALUE":
+			if !m.has("strict") || m.bool("strict") {
+				t.add(prefix, Pattern, headerValuePattern)
+			} else {
+				t.add(prefix, Pattern, looseHeaderValuePattern)
+			}
+		}
+	default:
+		t.unsupported(wk, "there is no built-in check for it")
+	}
+	if m.bool("ignore_empty") {
+		t.unsupported("ignore_empty", "the rules are applied to the empty string too")
+	}
+}
+
+func (t *ruleTranslator) bytes(prefix string, m ruleMessage) {
+	if m.has("const") {
+		t.add(prefix, Const, m.format("const"))
+	}
+	t.sizes(prefix, m, "len", "min_len", "max_len")
+	t.binary(prefix, m)
+	if wk := m.which("well_known"); wk != "" {
+		t.unsupported(wk, "there is no built-in check for it")
+	}
+	if m.bool("ignore_empty") {
+		t.unsupported("ignore_empty", "the rules are applied to the empty bytes too")
+	}
+}
+
+// sizes adds min_size and max_size from the rules counted in bytes.
+func (t *ruleTranslator) sizes(prefix string, m ruleMessage, exact, min, max string) {
+	if m.has(exact) {
+		t.add(prefix, MinSize, m.format(exact))
+		t.add(prefix, MaxSize, m.format(exact))
+	}
+	if m.has(min) {
+		t.add(prefix, MinSize, m.format(min))
+	}
+	if m.has(max) {
+		t.add(prefix, MaxSize, m.format(max))
+	}
+}
+
+// binary adds the rules shared by strings and bytes.
+func (t *ruleTranslator) binary(prefix string, m ruleMessage) {
+	for _, key := range []Key{Pattern, Prefix, Suffix, Contains, NotContains} {
+		if name := KeyString[key]; m.has(name) {
+			t.add(prefix, key, m.format(name))
+		}
+	}
+	t.lists(prefix, m)
+}
+
+func (t *ruleTranslator) enum(prefix string, fd protoreflect.FieldDescriptor, m ruleMessage) {
+	if m.has("const") {
+		number := int32(m.Get(m.Descriptor().Fields().ByName("const")).Int())
+		if name, ok := enumConstName(fd.Enum(), number); ok {
+			t.add(prefix, Const, name)
+		} else {
+			t.unsupported("const", fmt.Sprintf("%d can not be referred as a top level enum value", number))
+		}
+	}
+	if m.bool("defined_only") {
+		t.add(prefix, DefinedOnly, "true")
+	}
+	for _, key := range []Key{In, NotIn} {
+		rule := m.field(KeyString[key])
+		if rule == nil || m.Get(rule).List().Len() == 0 {
+			continue
+		}
+		list := m.Get(rule).List()
+		names := make([]string, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			v := fd.Enum().Values().ByNumber(protoreflect.EnumNumber(list.Get(i).Int()))
+			if v == nil {
+				t.unsupported(KeyString[key], fmt.Sprintf("%d is not a value of %s", list.Get(i).Int(), fd.Enum().FullName()))
+				names = nil
+				break
+			}
+			names = append(names, enumValueName(fd.Enum(), v))
+		}
+		if names != nil {
+			t.add(prefix, key, names...)
+		}
+	}
+}
+
+// enumValueName returns v in the EnumType.VALUE form, the in and not_in rules of
+// enums only read the name of the value.
+func enumValueName(enum protoreflect.EnumDescriptor, v protoreflect.EnumValueDescriptor) string {
+	return string(enum.Name()) + "." + string(v.Name())
+}
+
+// enumConstName returns the value of number in the EnumType.VALUE form read by
+// the enum const rule.
+func enumConstName(enum protoreflect.EnumDescriptor, number int32) (string, bool) {
+	v := enum.Values().ByNumber(protoreflect.EnumNumber(number))
+	if v == nil || enum.Parent() != enum.ParentFile() {
+		return "", false
+	}
+	return string(enum.Name()) + "." + string(v.Name()), true
+}
+
+func (t *ruleTranslator) repeated(prefix string, fd protoreflect.FieldDescriptor, m ruleMessage) {
+	t.sizes(prefix, m, "", "min_items", "max_items")
+	if m.bool("unique") {
+		t.unsupported("unique", "there is no uniqueness check for lists")
+	}
+	if items, ok := m.message("items"); ok {
+		t.translate(prefix+KeyString[Elem]+".", fd, items.Message)
+	}
+	if m.bool("ignore_empty") {
+		t.unsupported("ignore_empty", "the rules are applied to the empty list too")
+	}
+}
+
+func (t *ruleTranslator) mapRules(prefix string, fd protoreflect.FieldDescriptor, m ruleMessage) {
+	t.sizes(prefix, m, "", "min_pairs", "max_pairs")
+	if m.bool("no_sparse") {
+		t.add(prefix, NoSparse, "true")
+	}
+	if keys, ok := m.message("keys"); ok {
+		t.translate(prefix+KeyString[MapKey]+".", fd.MapKey(), keys.Message)
+	}
+	if values, ok := m.message("values"); ok {
+		t.translate(prefix+KeyString[MapValue]+".", fd.MapValue(), values.Message)
+	}
+	if m.bool("ignore_empty") {
+		t.unsupported("ignore_empty", "the rules are applied to the empty map too")
+	}
+}
+
+// ruleMessage reads the rules by name, a name missing in the message reads as
+// not set.
+type ruleMessage struct {
+	protoreflect.Message
+}
+
+func (m ruleMessage) field(name string) protoreflect.FieldDescriptor {
+	return m.Descriptor().Fields().ByName(protoreflect.Name(name))
+}
+
+func (m ruleMessage) has(name string) bool {
+	fd := m.field(name)
+	return fd != nil && m.Has(fd)
+}
+
+func (m ruleMessage) bool(name string) bool {
+	return m.has(name) && m.Get(m.field(name)).Bool()
+}
+
+func (m ruleMessage) float(name string) float64 {
+	if !m.has(name) {
+		return 0
+	}
+	f, _ := strconv.ParseFloat(m.format(name), 64)
+	return f
+}
+
+func (m ruleMessage) message(name string) (ruleMessage, bool) {
+	if !m.has(name) {
+		return ruleMessage{}, false
+	}
+	return ruleMessage{m.Get(m.field(name)).Message()}, true
+}
+
+// which returns the name of the field set in a oneof.
+func (m ruleMessage) which(oneof string) string {
+	od := m.Descriptor().Oneofs().ByName(protoreflect.Name(oneof))
+	if od == nil {
+		return ""
+	}
+	if fd := m.WhichOneof(od); fd != nil {
+		return string(fd.Name())
+	}
+	return ""
+}
+
+func (m ruleMessage) format(name string) string {
+	fd := m.field(name)
+	return formatRuleValue(fd, m.Get(fd))
+}
+
+func (m ruleMessage) list(name string) []string {
+	fd := m.field(name)
+	if fd == nil || !fd.IsList() {
+		return nil
+	}
+	list := m.Get(fd).List()
+	var ret []string
+	for i := 0; i < list.Len(); i++ {
+		ret = append(ret, formatRuleValue(fd, list.Get(i)))
+	}
+	return ret
+}
+
+func formatRuleValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.FloatKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 32)
+	case protoreflect.DoubleKind:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case protoreflect.BytesKind:
+		return string(v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
@@ -16,6 +16,8 @@ package parser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
@@ -62,6 +64,12 @@ func (vt ValueType) String() string {
 type Validation struct {
 	ValidationType ValidationType
 	Rules          []*Rule
+	// When is the condition of the rules of a field, see the when of FieldRules.
+	When *ToolFunction
+	// AllowNaN lets NaN pass the range rules of floats, see the allow_nan of FieldRules.
+	AllowNaN bool
+	// Epsilon is the tolerance of the const of floats, see the epsilon of FieldRules.
+	Epsilon float64
 }
 
 type Rule struct {
@@ -69,6 +77,27 @@ type Rule struct {
 	Specified *ValidationValue
 	Range     []*ValidationValue
 	Inner     *Validation
+	// Message is the template of the error message, see the msg of FieldRules.
+	Message string
+	// Severity is SeverityError or SeverityWarn, the default of the plugin is used
+	// if it's empty.
+	Severity string
+}
+
+// the severities of the rules, the violations of the warn rules are reported and
+// do not fail the validation
+const (
+	SeverityError = "error"
+	SeverityWarn  = "warn"
+)
+
+// IsWarn reports whether the rule is of the warn severity, defaultSeverity is used
+// if the rule has no severity.
+func (r *Rule) IsWarn(defaultSeverity string) bool {
+	if r.Severity != "" {
+		return r.Severity == SeverityWarn
+	}
+	return defaultSeverity == SeverityWarn
 }
 
 type ToolFunction struct {
@@ -91,6 +120,58 @@ type TypedValidationValue struct {
 	Function *ToolFunction
 }
 
+// String returns the value as written in the annotation, e.g. "$Field" or "@len($List)".
+func (v *ValidationValue) String() string {
+	switch v.ValueType {
+	case FieldReferenceValue:
+		return "$" + string(v.TypedValue.FieldReference.Desc.Name())
+	case DoubleValue:
+		return strconv.FormatFloat(v.TypedValue.Double, 'f', -1, 64)
+	case IntValue:
+		return strconv.FormatInt(v.TypedValue.Int, 10)
+	case BoolValue:
+		return strconv.FormatBool(v.TypedValue.Bool)
+	case FunctionValue:
+		return v.TypedValue.Function.String()
+	default:
+		return v.TypedValue.Binary
+	}
+}
+
+func (f *ToolFunction) String() string {
+	args := make([]string, 0, len(f.Arguments))
+	for _, arg := range f.Arguments {
+		if arg.ValueType == BinaryValue || arg.ValueType == EnumValue {
+			args = append(args, strconv.Quote(arg.TypedValue.Binary))
+			continue
+		}
+		args = append(args, arg.String())
+	}
+	return "@" + f.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Constraint returns the constraint of the rule as written in the annotation, the
+// values of in and not_in are listed in brackets.
+func (r *Rule) Constraint() string {
+	if len(r.Range) > 0 {
+		vals := make([]string, 0, len(r.Range))
+		for _, v := range r.Range {
+			vals = append(vals, v.String())
+		}
+		return "[" + strings.Join(vals, ", ") + "]"
+	}
+	if r.Specified == nil {
+		return ""
+	}
+	if r.Specified.ValueType == BinaryValue {
+		// the binary values are the content of go literals
+		if s, err := strconv.Unquote("\"" + r.Specified.TypedValue.Binary + "\""); err == nil {
+			return s
+		}
+	}
+	return r.Specified.String()
+}
+
 func (t *TypedValidationValue) GetFieldReferenceName(ref string) string {
 	fName := t.FieldReference.GoName
 	reference := ref + fmt.Sprintf("Get%s()", fName)
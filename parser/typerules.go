@@ -0,0 +1,108 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ResolveFieldRules returns the rules of a field with the rule sets expanded and
+// the default rules of its message or enum type merged under them, the defaults
+// apply to the elements of the lists and the values of the maps.
+func ResolveFieldRules(fd protoreflect.FieldDescriptor) (*api.FieldRules, error) {
+	rules := proto.GetExtension(fd.Options(), api.E_Vt).(*api.FieldRules)
+	if proto.HasExtension(fd.Options(), api.E_VtCompatible) {
+		rules = proto.GetExtension(fd.Options(), api.E_VtCompatible).(*api.FieldRules)
+	}
+	rules, err := ResolveRules(fd.ParentFile(), rules)
+	if err != nil {
+		return nil, err
+	}
+	valueDesc := fd
+	if fd.IsMap() {
+		valueDesc = fd.MapValue()
+	}
+	typeRules, err := resolveTypeRules(valueDesc)
+	if err != nil || typeRules == nil {
+		return rules, err
+	}
+	if rules == nil {
+		rules = &api.FieldRules{}
+	}
+	switch {
+	case fd.IsList():
+		rules.Elem = mergeTypeRules(typeRules, rules.Elem)
+	case fd.IsMap():
+		rules.Value = mergeTypeRules(typeRules, rules.Value)
+	default:
+		rules = mergeTypeRules(typeRules, rules)
+	}
+	return rules, nil
+}
+
+func mergeTypeRules(typeRules, rules *api.FieldRules) *api.FieldRules {
+	if rules != nil {
+		mergeRules(typeRules, rules)
+	}
+	return typeRules
+}
+
+// resolveTypeRules returns the msg_type_vt or enum_type_vt of the type of a field,
+// nil is returned if it has none.
+func resolveTypeRules(fd protoreflect.FieldDescriptor) (*api.FieldRules, error) {
+	var (
+		rules *api.FieldRules
+		name  protoreflect.FullName
+		file  protoreflect.FileDescriptor
+	)
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		rules = proto.GetExtension(fd.Message().Options(), api.E_MsgTypeVt).(*api.FieldRules)
+		name, file = fd.Message().FullName(), fd.Message().ParentFile()
+	case protoreflect.EnumKind:
+		rules = proto.GetExtension(fd.Enum().Options(), api.E_EnumTypeVt).(*api.FieldRules)
+		name, file = fd.Enum().FullName(), fd.Enum().ParentFile()
+	}
+	if rules == nil {
+		return nil, nil
+	}
+	if err := checkTypeRules(rules); err != nil {
+		return nil, fmt.Errorf("default rules of %s: %w", name, err)
+	}
+	ret, err := ResolveRules(file, rules)
+	if err != nil {
+		return nil, fmt.Errorf("default rules of %s: %w", name, err)
+	}
+	return ret, nil
+}
+
+// checkTypeRules checks the default rules of a type, which have no fields to refer
+// to and are applied to the elements and values as well.
+func checkTypeRules(rules *api.FieldRules) error {
+	switch {
+	case rules.When != nil:
+		return errors.New("when is not applicable to the default rules of types")
+	case rules.Default != nil:
+		return errors.New("default is not applicable to the default rules of types")
+	case rules.Key != nil || rules.Value != nil || rules.Elem != nil:
+		return errors.New("key, value and elem are not applicable to the default rules of types")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestResolveFieldRules(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/run.pb", "", "run.proto")
+	order := gen.FilesByPath["run.proto"].Desc.Messages().ByName("Order")
+	tests := []struct {
+		field protoreflect.Name
+		want  string
+	}{
+		{"total", `not_nil:"true"`},
+		{"discount", `not_nil:"false"`},
+		{"items", `elem:{not_nil:"true"}`},
+		{"prices", `value:{not_nil:"false"}`},
+		{"currency", `not_in:"Currency.CURRENCY_UNSPECIFIED"`},
+		{"currencies", `elem:{not_in:"Currency.CURRENCY_UNSPECIFIED"}`},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.field), func(t *testing.T) {
+			got, err := ResolveFieldRules(order.Fields().ByName(tt.field))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := &api.FieldRules{}
+			if err := prototext.Unmarshal([]byte(tt.want), want); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("ResolveFieldRules() = {%v}, want {%v}", got, want)
+			}
+		})
+	}
+	// the fields of the types without default rules are left as is
+	name := gen.FilesByPath["run.proto"].Desc.Messages().ByName("Money").Fields().ByName("currency")
+	if got, err := ResolveFieldRules(name); err != nil || got.GetMinSize() != "3" || got.NotNil != nil {
+		t.Errorf("ResolveFieldRules() = {%v}, %v, want the rules of the field", got, err)
+	}
+}
+
+func TestCheckTypeRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules *api.FieldRules
+		err   string
+	}{
+		{name: "valid", rules: &api.FieldRules{NotNil: proto.String("true"), Use: proto.String("money")}},
+		{name: "when", rules: &api.FieldRules{When: proto.String("@has($a)")}, err: "when is not applicable to the default rules of types"},
+		{name: "default", rules: &api.FieldRules{Default: proto.String("1")}, err: "default is not applicable to the default rules of types"},
+		{name: "elem", rules: &api.FieldRules{Elem: &api.FieldRules{}}, err: "key, value and elem are not applicable to the default rules of types"},
+		{name: "key", rules: &api.FieldRules{Key: &api.FieldRules{}}, err: "key, value and elem are not applicable to the default rules of types"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTypeRules(tt.rules)
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.err {
+				t.Errorf("checkTypeRules() = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
@@ -17,11 +17,13 @@ package parser
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/cloudwego/protoc-gen-validator/parser/api"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // RulesToAnnotations convert the rule struct to []*Annotation
@@ -37,14 +39,30 @@ func RulesToAnnotations(fieldRules *api.FieldRules) ([]*Annotation, error) {
 		return nil, err
 	}
 
-	for k, v := range m {
+	// iterate in a stable order so that the generated code is reproducible
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v := m[k]
+		if !isRuleKey(k) {
+			continue
+		}
 		if k == KeyString[MapKey] || k == KeyString[MapValue] || k == KeyString[Elem] {
 			rule := v.(map[string]interface{})
 			ret, err := getElemRule(rule, k)
 			if err != nil {
 				return nil, err
 			}
-			for k, v := range ret {
+			elemKeys := make([]string, 0, len(ret))
+			for k := range ret {
+				elemKeys = append(elemKeys, k)
+			}
+			sort.Strings(elemKeys)
+			for _, k := range elemKeys {
+				v := ret[k]
 				var value []string
 				for _, val := range v {
 					value = append(value, val)
@@ -83,6 +101,9 @@ func getElemRule(rules map[string]interface{}, originkey string) (map[string][]s
 	ret := make(map[string][]string, len(rules))
 	// elem rule don't nest elem rule in protobuf, so no need to process "MapKey"、"MapValue"、"Elem"
 	for ruleKey, ruleContent := range rules {
+		if !isRuleKey(ruleKey) {
+			continue
+		}
 		if ruleKey == KeyString[In] || ruleKey == KeyString[NotIn] {
 			var value []string
 			for _, val := range ruleContent.([]interface{}) {
@@ -168,6 +189,9 @@ func getFunctionValidation(st *protogen.Message, anno string) (*ValidationValue,
 	if err != nil {
 		return nil, err
 	}
+	if err = castEnumNames(name, arguments); err != nil {
+		return nil, err
+	}
 	return &ValidationValue{
 		ValueType: FunctionValue,
 		TypedValue: TypedValidationValue{Function: &ToolFunction{
@@ -177,6 +201,39 @@ func getFunctionValidation(st *protogen.Message, anno string) (*ValidationValue,
 	}, nil
 }
 
+// castEnumNames casts the string literals compared with the enum fields to the enum
+// values, e.g. "PHYSICAL" of @equal($delivery_type, "PHYSICAL"), which keep the
+// names in Binary and the numbers in Int.
+func castEnumNames(name string, args []ValidationValue) error {
+	switch name {
+	case "equal", "ne", "lt", "le", "gt", "ge":
+	default:
+		return nil
+	}
+	if len(args) < 2 {
+		return nil
+	}
+	for i := 0; i < 2; i++ {
+		ref, lit := &args[i], &args[1-i]
+		if ref.ValueType != FieldReferenceValue || lit.ValueType != BinaryValue {
+			continue
+		}
+		fd := ref.TypedValue.FieldReference.Desc
+		if fd.Kind() != protoreflect.EnumKind || fd.IsList() || fd.IsMap() {
+			continue
+		}
+		ev := fd.Enum().Values().ByName(protoreflect.Name(lit.TypedValue.Binary))
+		if ev == nil {
+			return fmt.Errorf("enum %s has no value %s", fd.Enum().FullName(), lit.TypedValue.Binary)
+		}
+		*lit = ValidationValue{
+			ValueType:  EnumValue,
+			TypedValue: TypedValidationValue{Int: int64(ev.Number()), Binary: string(ev.Name())},
+		}
+	}
+	return nil
+}
+
 func parseFunction(anno string, st *protogen.Message) (*Function, error) {
 	f := &Function{
 		Buffer: anno,
@@ -0,0 +1,726 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cloudwego/protoc-gen-validator/parser/api"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const vdSource = "vd"
+
+// vdAnnotations compiles the (api.vd) of a field, the go-tagexpr expression used
+// by hertz binding. The top-level conditions on $ that have an equivalent vt rule
+// are returned as annotations, the others are compiled to an assert of msg. The
+// expressions that can not be compiled are logged with their location and dropped.
+func vdAnnotations(msg *protogen.Message, field *protogen.Field) ([]*Annotation, *Rule) {
+	if !proto.HasExtension(field.Desc.Options(), api.E_Vd) {
+		return nil, nil
+	}
+	expr := proto.GetExtension(field.Desc.Options(), api.E_Vd).(string)
+	annos, assert, err := CompileVD(msg, field, expr)
+	if err != nil {
+		log.Printf("[%s] %s: %s: %q is ignored, %s\n", vdSource, sourcePosition(field, protoreflect.FieldNumber(api.E_Vd.Field)), field.Desc.FullName(), expr, err)
+		return nil, nil
+	}
+	var rule *Rule
+	if assert != nil {
+		rule = &Rule{Key: Assert, Specified: &ValidationValue{ValueType: FunctionValue, TypedValue: TypedValidationValue{Function: assert}}}
+	}
+	return annos, rule
+}
+
+// sourcePosition returns file:line:column of the option of field, or of field
+// itself when the option is not located.
+func sourcePosition(field *protogen.Field, option protoreflect.FieldNumber) string {
+	file := field.Desc.ParentFile()
+	path := append(protoreflect.SourcePath{}, field.Location.Path...)
+	// 8 is the options of FieldDescriptorProto
+	loc := file.SourceLocations().ByPath(append(path, 8, int32(option)))
+	if loc.Path == nil {
+		loc = file.SourceLocations().ByDescriptor(field.Desc)
+	}
+	if loc.Path == nil {
+		return file.Path()
+	}
+	return fmt.Sprintf("%s:%d:%d", file.Path(), loc.StartLine+1, loc.StartColumn+1)
+}
+
+// CompileVD compiles a go-tagexpr expression of field, $ is the value of field
+// and (Name)$ is the value of the field Name of msg. The "msg" selector is
+// skipped. Literals, len(), mblen(), regexp(), in(), comparisons, !, && and ||
+// are supported, regexp() only as a top-level condition.
+func CompileVD(msg *protogen.Message, field *protogen.Field, expr string) ([]*Annotation, *ToolFunction, error) {
+	src, offset, err := vdSelector(expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	c := &vdCompiler{msg: msg, field: field, src: src, offset: offset}
+	if err = c.next(); err != nil {
+		return nil, nil, err
+	}
+	e, err := c.or()
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.tok.kind != celEOF {
+		return nil, nil, c.errorf("unexpected %q", c.tok.text)
+	}
+	if e.typ != celBool {
+		return nil, nil, fmt.Errorf("the expression is a %s instead of a bool", celTypeName[e.typ])
+	}
+	conds := e.conjuncts
+	if conds == nil {
+		conds = []*vdExpr{e}
+	}
+	var annos []*Annotation
+	var args []ValidationValue
+	for _, cond := range conds {
+		if cond.rules != nil {
+			annos = append(annos, cond.rules...)
+			continue
+		}
+		if cond.value.ValueType == FunctionValue {
+			args = append(args, cond.value)
+		} else {
+			// a bool field or literal on its own
+			args = append(args, functionValue(call("equal", cond.value, boolValue(true))))
+		}
+	}
+	switch len(args) {
+	case 0:
+		return annos, nil, nil
+	case 1:
+		return annos, args[0].TypedValue.Function, nil
+	default:
+		return annos, call("and", args...), nil
+	}
+}
+
+var vdSelectorName = regexp.MustCompile(`^\s*(@|[A-Za-z_]\w*)\s*:`)
+
+// vdSelector picks the validating expression from the selectors separated by ;,
+// such as "@:$>0; msg:'must be positive'", and returns it with its offset.
+func vdSelector(expr string) (string, int, error) {
+	var parts []string
+	var quoted bool
+	start := 0
+	for i := 0; i < len(expr); i++ {
+		switch {
+		case expr[i] == '\\' && quoted:
+			i++
+		case expr[i] == '\'':
+			quoted = !quoted
+		case expr[i] == ';' && !quoted:
+			parts = append(parts, expr[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, expr[start:])
+	src, offset, found := "", 0, false
+	pos := 0
+	for _, part := range parts {
+		name, body := "@", part
+		if m := vdSelectorName.FindStringSubmatch(part); m != nil {
+			name, body = m[1], part[len(m[0]):]
+		}
+		switch name {
+		case "@":
+			if found {
+				return "", 0, errors.New("more than one expression")
+			}
+			src, offset, found = body, pos+len(part)-len(body), true
+		case "msg":
+			// the message is not used by the generated code
+		default:
+			return "", 0, fmt.Errorf("unknown selector %s", name)
+		}
+		pos += len(part) + 1
+	}
+	if strings.TrimSpace(src) == "" {
+		return "", 0, errors.New("no expression")
+	}
+	return src, offset, nil
+}
+
+// vdExpr is a compiled sub-expression of a vd expression.
+type vdExpr struct {
+	celExpr
+	// current is set for $, the value of the field the expression is on, and
+	// length for len($).
+	current bool
+	length  bool
+	null    bool
+	// rules are the equivalent vt rules of a condition on $, they are used
+	// instead of the assert when the condition is at the top level.
+	rules []*Annotation
+	// topOnly is set for the conditions that can only be expressed as rules.
+	topOnly bool
+	// conjuncts are the operands of the top-level &&.
+	conjuncts []*vdExpr
+}
+
+type vdCompiler struct {
+	msg    *protogen.Message
+	field  *protogen.Field
+	src    string
+	offset int
+	pos    int
+	tok    celToken
+}
+
+func (c *vdCompiler) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("%s at column %d", fmt.Sprintf(format, a...), c.offset+c.tok.pos+1)
+}
+
+var vdOperators = []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", ",", "$", ".", "+", "-", "*", "/", "%", "?", ":", "[", "]", "{", "}"}
+
+func (c *vdCompiler) next() error {
+	for c.pos < len(c.src) && unicode.IsSpace(rune(c.src[c.pos])) {
+		c.pos++
+	}
+	start := c.pos
+	c.tok = celToken{pos: start}
+	if c.pos >= len(c.src) {
+		c.tok.kind = celEOF
+		return nil
+	}
+	ch := c.src[c.pos]
+	switch {
+	case ch == '_' || unicode.IsLetter(rune(ch)):
+		for c.pos < len(c.src) && (c.src[c.pos] == '_' || unicode.IsLetter(rune(c.src[c.pos])) || unicode.IsDigit(rune(c.src[c.pos]))) {
+			c.pos++
+		}
+		c.tok.kind = celIdent
+	case unicode.IsDigit(rune(ch)):
+		for c.pos < len(c.src) && (unicode.IsDigit(rune(c.src[c.pos])) || strings.IndexByte(".eExXabcdefABCDEF", c.src[c.pos]) >= 0 ||
+			(c.src[c.pos] == '+' || c.src[c.pos] == '-') && (c.src[c.pos-1] == 'e' || c.src[c.pos-1] == 'E') && !strings.HasPrefix(c.src[start:], "0x")) {
+			c.pos++
+		}
+		c.tok.kind = celNumber
+	case ch == '\'':
+		// backslashes are kept as they are except the escaped quotes, so the
+		// patterns are written as in go-tagexpr
+		c.pos++
+		var b strings.Builder
+		for {
+			if c.pos >= len(c.src) {
+				return c.errorf("unterminated string")
+			}
+			ch := c.src[c.pos]
+			if ch == '\'' {
+				c.pos++
+				break
+			}
+			if ch == '\\' && c.pos+1 < len(c.src) && c.src[c.pos+1] == '\'' {
+				c.pos++
+				ch = '\''
+			}
+			b.WriteByte(ch)
+			c.pos++
+		}
+		c.tok.kind = celStringLit
+		c.tok.str = b.String()
+	default:
+		for _, op := range vdOperators {
+			if strings.HasPrefix(c.src[c.pos:], op) {
+				c.pos += len(op)
+				c.tok.kind = celOperator
+				c.tok.text = op
+				return nil
+			}
+		}
+		return c.errorf("unexpected character %q", ch)
+	}
+	c.tok.text = c.src[start:c.pos]
+	return nil
+}
+
+func (c *vdCompiler) is(op string) bool {
+	return c.tok.kind == celOperator && c.tok.text == op
+}
+
+func (c *vdCompiler) expect(op string) error {
+	if !c.is(op) {
+		return c.errorf("expect %q", op)
+	}
+	return c.next()
+}
+
+func (c *vdCompiler) or() (*vdExpr, error) {
+	e, err := c.and()
+	if err != nil || !c.is("||") {
+		return e, err
+	}
+	args := []ValidationValue{}
+	for {
+		if err = c.operand("||", e); err != nil {
+			return nil, err
+		}
+		args = append(args, e.value)
+		if !c.is("||") {
+			break
+		}
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+		if e, err = c.and(); err != nil {
+			return nil, err
+		}
+	}
+	return &vdExpr{celExpr: celExpr{value: functionValue(call("or", args...)), typ: celBool}}, nil
+}
+
+// and keeps the operands, so the top-level conditions can be translated to rules.
+func (c *vdCompiler) and() (*vdExpr, error) {
+	e, err := c.relation()
+	if err != nil || !c.is("&&") {
+		return e, err
+	}
+	ret := &vdExpr{celExpr: celExpr{typ: celBool}}
+	args := []ValidationValue{}
+	for {
+		ret.conjuncts = append(ret.conjuncts, e)
+		if e.typ != celBool {
+			return nil, c.errorf("the operands of && must be bool, got %s", celTypeName[e.typ])
+		}
+		if !e.topOnly {
+			args = append(args, e.value)
+		}
+		if !c.is("&&") {
+			break
+		}
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+		if e, err = c.relation(); err != nil {
+			return nil, err
+		}
+	}
+	if len(args) == len(ret.conjuncts) {
+		ret.value = functionValue(call("and", args...))
+	} else {
+		ret.topOnly = true
+	}
+	return ret, nil
+}
+
+// operand checks an operand of a logical operator.
+func (c *vdCompiler) operand(op string, e *vdExpr) error {
+	switch {
+	case e.typ != celBool:
+		return c.errorf("the operands of %s must be bool, got %s", op, celTypeName[e.typ])
+	case e.topOnly:
+		return c.errorf("regexp() is only supported as a top-level condition")
+	}
+	return nil
+}
+
+var vdReversed = map[string]string{"==": "==", "!=": "!=", "<": ">", "<=": ">=", ">": "<", ">=": "<="}
+
+func (c *vdCompiler) relation() (*vdExpr, error) {
+	a, err := c.unary()
+	if err != nil {
+		return nil, err
+	}
+	if c.tok.kind != celOperator {
+		return a, nil
+	}
+	op := c.tok.text
+	name, ok := celRelations[op]
+	if !ok {
+		if strings.Contains("+-*/%?[{.", op) {
+			return nil, c.errorf("operator %s is not supported", op)
+		}
+		return a, nil
+	}
+	if err = c.next(); err != nil {
+		return nil, err
+	}
+	b, err := c.unary()
+	if err != nil {
+		return nil, err
+	}
+	if a.topOnly || b.topOnly {
+		return nil, c.errorf("regexp() is only supported as a top-level condition")
+	}
+	if a.null || b.null {
+		return c.nullRelation(op, a, b)
+	}
+	if err = comparable(op, &a.celExpr, &b.celExpr); err != nil {
+		return nil, err
+	}
+	e := &vdExpr{celExpr: celExpr{value: functionValue(call(name, a.value, b.value)), typ: celBool}}
+	if b.current || b.length {
+		a, b, op = b, a, vdReversed[op]
+	}
+	if b.literal {
+		e.rules = c.rules(op, a, b)
+	}
+	return e, nil
+}
+
+// nullRelation compiles the comparisons with nil to has() for the fields with
+// presence, and to the length for lists and maps.
+func (c *vdCompiler) nullRelation(op string, a, b *vdExpr) (*vdExpr, error) {
+	if a.null {
+		a, b = b, a
+	}
+	if b.null && a.null || a.value.ValueType != FieldReferenceValue {
+		return nil, c.errorf("only fields can be compared with nil")
+	}
+	if op != "==" && op != "!=" {
+		return nil, c.errorf("nil can not be compared with %s", op)
+	}
+	f := a.value.TypedValue.FieldReference
+	e := &vdExpr{celExpr: celExpr{typ: celBool}}
+	switch {
+	case f.Desc.IsList() || f.Desc.IsMap():
+		cmp := call(celRelations[op], functionValue(call("len", a.value)), ValidationValue{ValueType: IntValue})
+		e.value = functionValue(cmp)
+		if a.current && op == "!=" {
+			e.rules = []*Annotation{{Key: validatorPrefix + "." + KeyString[MinSize], Values: []string{"1"}}}
+		}
+	case f.Desc.HasPresence():
+		has := call("has", a.value)
+		if op == "==" {
+			has = call("not", functionValue(has))
+		}
+		e.value = functionValue(has)
+		if oneof := f.Desc.ContainingOneof(); a.current && op == "!=" && (oneof == nil || oneof.IsSynthetic()) {
+			e.rules = []*Annotation{{Key: validatorPrefix + "." + KeyString[NotNil], Values: []string{"true"}}}
+		}
+	default:
+		return nil, c.errorf("field %s can not be nil", f.Desc.Name())
+	}
+	return e, nil
+}
+
+// rules returns the vt rules of comparing $ or len($) to a literal.
+func (c *vdCompiler) rules(op string, a, lit *vdExpr) []*Annotation {
+	rule := func(key Key, val string) *Annotation {
+		return &Annotation{Key: validatorPrefix + "." + KeyString[key], Values: []string{val}}
+	}
+	if a.length {
+		n := lit.value.TypedValue.Int
+		switch op {
+		case "==":
+			return []*Annotation{rule(MinSize, strconv.FormatInt(n, 10)), rule(MaxSize, strconv.FormatInt(n, 10))}
+		case "<":
+			if n > 0 {
+				return []*Annotation{rule(MaxSize, strconv.FormatInt(n-1, 10))}
+			}
+		case "<=":
+			return []*Annotation{rule(MaxSize, strconv.FormatInt(n, 10))}
+		case ">":
+			return []*Annotation{rule(MinSize, strconv.FormatInt(n+1, 10))}
+		case ">=":
+			return []*Annotation{rule(MinSize, strconv.FormatInt(n, 10))}
+		}
+		return nil
+	}
+	if !a.current || c.field.Desc.Kind() == protoreflect.EnumKind {
+		return nil
+	}
+	val := vdLiteral(lit)
+	if strings.HasPrefix(val, "$") || strings.HasPrefix(val, "@") {
+		// would be read as a field reference or a function
+		return nil
+	}
+	switch a.typ {
+	case celInt, celUint, celDouble:
+		keys := map[string]Key{"==": Const, "!=": NotIn, "<": LessThan, "<=": LessEqual, ">": GreatThan, ">=": GreatEqual}
+		return []*Annotation{rule(keys[op], val)}
+	case celString, celBytes:
+		switch op {
+		case "==":
+			return []*Annotation{rule(Const, val)}
+		case "!=":
+			if val == "" {
+				return []*Annotation{rule(MinSize, "1")}
+			}
+			return []*Annotation{rule(NotIn, val)}
+		}
+	case celBool:
+		if op == "==" {
+			return []*Annotation{rule(Const, val)}
+		}
+	}
+	return nil
+}
+
+func vdLiteral(e *vdExpr) string {
+	v := e.value.TypedValue
+	switch e.value.ValueType {
+	case DoubleValue:
+		return strconv.FormatFloat(v.Double, 'g', -1, 64)
+	case BinaryValue:
+		return v.Binary
+	case BoolValue:
+		return strconv.FormatBool(v.Bool)
+	default:
+		return strconv.FormatInt(v.Int, 10)
+	}
+}
+
+func (c *vdCompiler) unary() (*vdExpr, error) {
+	switch {
+	case c.is("!"):
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.unary()
+		if err != nil {
+			return nil, err
+		}
+		if err = c.operand("!", e); err != nil {
+			return nil, err
+		}
+		return &vdExpr{celExpr: celExpr{value: functionValue(call("not", e.value)), typ: celBool}}, nil
+	case c.is("-"):
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.unary()
+		if err != nil {
+			return nil, err
+		}
+		if !e.literal || e.typ != celInt && e.typ != celDouble {
+			return nil, c.errorf("negation is only supported for number literals")
+		}
+		e.value.TypedValue.Int = -e.value.TypedValue.Int
+		e.value.TypedValue.Double = -e.value.TypedValue.Double
+		return e, nil
+	}
+	return c.primary()
+}
+
+func (c *vdCompiler) primary() (*vdExpr, error) {
+	tok := c.tok
+	switch tok.kind {
+	case celEOF:
+		return nil, c.errorf("unexpected end of expression")
+	case celNumber:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		// the numbers of go-tagexpr are float64, the integers are compiled as ints
+		// to be compared with the integer fields
+		e, err := numberExpr(tok.text)
+		if err != nil {
+			return nil, fmt.Errorf("%v at column %d", err, c.offset+tok.pos+1)
+		}
+		return &vdExpr{celExpr: *e}, nil
+	case celStringLit:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		return &vdExpr{celExpr: celExpr{value: ValidationValue{ValueType: BinaryValue, TypedValue: TypedValidationValue{Binary: tok.str}}, typ: celString, literal: true}}, nil
+	case celIdent:
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		switch tok.text {
+		case "true", "false":
+			return &vdExpr{celExpr: celExpr{value: boolValue(tok.text == "true"), typ: celBool, literal: true}}, nil
+		case "nil":
+			return &vdExpr{null: true}, nil
+		}
+		if c.is("(") {
+			args, err := c.arguments()
+			if err != nil {
+				return nil, err
+			}
+			return c.function(tok, args)
+		}
+		return nil, fmt.Errorf("unknown identifier %s at column %d", tok.text, c.offset+tok.pos+1)
+	}
+	switch {
+	case c.is("$"):
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		return &vdExpr{celExpr: *fieldExpr(c.field), current: true}, nil
+	case c.is("("):
+		if e, err := c.reference(); e != nil || err != nil {
+			return e, err
+		}
+		if err := c.next(); err != nil {
+			return nil, err
+		}
+		e, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		if e.conjuncts != nil {
+			// the parenthesized && is not a top-level condition any more
+			if err = c.operand("&&", e); err != nil {
+				return nil, err
+			}
+			e = &vdExpr{celExpr: e.celExpr}
+		}
+		return e, c.expect(")")
+	}
+	return nil, c.errorf("unexpected %q", tok.text)
+}
+
+// reference compiles (Name)$, the value of another field. It returns nil and
+// rewinds if the parenthesis is not a field reference.
+func (c *vdCompiler) reference() (*vdExpr, error) {
+	pos, tok := c.pos, c.tok
+	var path []string
+	var err error
+	for err = c.next(); err == nil && c.tok.kind == celIdent; err = c.next() {
+		path = append(path, c.tok.text)
+		if err = c.next(); err != nil || !c.is(".") {
+			break
+		}
+	}
+	if err == nil && len(path) > 0 && c.is(")") {
+		if err = c.next(); err == nil && c.is("$") {
+			if len(path) > 1 {
+				return nil, c.errorf("the fields of nested messages can not be referenced")
+			}
+			f := c.fieldByName(path[0])
+			if f == nil {
+				return nil, c.errorf("no field %s in %s", path[0], c.msg.Desc.FullName())
+			}
+			if err = c.next(); err != nil {
+				return nil, err
+			}
+			return &vdExpr{celExpr: *fieldExpr(f), current: f == c.field}, nil
+		}
+	}
+	c.pos, c.tok = pos, tok
+	return nil, nil
+}
+
+// fieldByName finds the field by the go name used by hertz, or the proto name.
+func (c *vdCompiler) fieldByName(name string) *protogen.Field {
+	for _, f := range c.msg.Fields {
+		if f.GoName == name || string(f.Desc.Name()) == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func (c *vdCompiler) arguments() ([]*vdExpr, error) {
+	if err := c.expect("("); err != nil {
+		return nil, err
+	}
+	var args []*vdExpr
+	for !c.is(")") {
+		arg, err := c.or()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if !c.is(",") {
+			break
+		}
+		if err = c.next(); err != nil {
+			return nil, err
+		}
+	}
+	return args, c.expect(")")
+}
+
+func (c *vdCompiler) function(tok celToken, args []*vdExpr) (*vdExpr, error) {
+	errorf := func(format string, a ...interface{}) error {
+		return fmt.Errorf("%s at column %d", fmt.Sprintf(format, a...), c.offset+tok.pos+1)
+	}
+	switch tok.text {
+	case "len", "mblen":
+		if len(args) != 1 || args[0].value.ValueType != FieldReferenceValue {
+			return nil, errorf("%s() needs a field argument", tok.text)
+		}
+		a := args[0]
+		switch a.typ {
+		case celString, celBytes, celList, celMap:
+		default:
+			return nil, errorf("%s() is not applicable for %s", tok.text, celTypeName[a.typ])
+		}
+		if tok.text == "mblen" {
+			// the length in runes
+			return &vdExpr{celExpr: celExpr{value: functionValue(call("size", a.value)), typ: celInt}}, nil
+		}
+		return &vdExpr{celExpr: celExpr{value: functionValue(call("len", a.value)), typ: celInt}, length: a.current}, nil
+	case "regexp":
+		if len(args) == 0 || len(args) > 2 || args[0].typ != celString || !args[0].literal {
+			return nil, errorf("regexp() needs a pattern literal")
+		}
+		if len(args) == 2 && !args[1].current {
+			return nil, errorf("regexp() is only supported for the field itself")
+		}
+		if k := c.field.Desc.Kind(); c.field.Desc.IsList() || c.field.Desc.IsMap() || k != protoreflect.StringKind && k != protoreflect.BytesKind {
+			return nil, errorf("regexp() is only applicable for strings")
+		}
+		pattern := args[0].value.TypedValue.Binary
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, errorf("invalid pattern: %v", err)
+		}
+		if strings.HasPrefix(pattern, "$") || strings.HasPrefix(pattern, "@") {
+			return nil, errorf("the pattern %q would be read as a field reference or a function", pattern)
+		}
+		return &vdExpr{
+			celExpr: celExpr{typ: celBool},
+			rules:   []*Annotation{{Key: validatorPrefix + "." + KeyString[Pattern], Values: []string{pattern}}},
+			topOnly: true,
+		}, nil
+	case "in":
+		if len(args) < 2 || args[0].value.ValueType != FieldReferenceValue {
+			return nil, errorf("in() needs a field and the values")
+		}
+		a := args[0]
+		var cmps []ValidationValue
+		var vals []string
+		for _, arg := range args[1:] {
+			if !arg.literal {
+				return nil, errorf("the values of in() must be literals")
+			}
+			if err := comparable("==", &a.celExpr, &arg.celExpr); err != nil {
+				return nil, errorf("%v", err)
+			}
+			cmps = append(cmps, functionValue(call("equal", a.value, arg.value)))
+			vals = append(vals, vdLiteral(arg))
+		}
+		e := &vdExpr{celExpr: celExpr{value: functionValue(call("or", cmps...)), typ: celBool}}
+		switch a.typ {
+		case celInt, celUint, celDouble, celString, celBytes:
+			if a.current && c.field.Desc.Kind() != protoreflect.EnumKind {
+				e.rules = []*Annotation{{Key: validatorPrefix + "." + KeyString[In], Values: vals}}
+				for _, val := range vals {
+					if strings.HasPrefix(val, "$") || strings.HasPrefix(val, "@") {
+						e.rules = nil
+					}
+				}
+			}
+		}
+		return e, nil
+	default:
+		return nil, errorf("function %s is not supported", tok.text)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func TestVD(t *testing.T) {
+	got := parse(t, "../testdata/vd.pb", "vd.proto")
+	checkRules(t, got, map[string][]string{
+		"Req": {
+			"assert=@le(@size($nick), 4)",
+			"assert=@or(@gt($max, $min), @equal($max, 0))",
+			"assert=@equal($kind, 1)",
+			"assert=@equal($ok, true)",
+			"assert=@or(@and(@gt($cnt, 0), @lt($cnt, 5)), @or(@equal($cnt, 10), @equal($cnt, 20)))",
+		},
+		"Req.age":   {"gt=0", "lt=150"},
+		"Req.name":  {"min_size=1", "max_size=9", `pattern=^\w+$`},
+		"Req.mode":  {"in=[a b]"},
+		"Req.opt":   {"not_nil=true"},
+		"Req.tags":  {"min_size=1", "max_size=3"},
+		"Req.score": {"ge=0", "le=1.5", "not_in=[0.5]"},
+		"Req.bad":   nil,
+		"Req.bad2":  nil,
+		"Req.bad3":  nil,
+		"Req.bad4":  nil,
+	})
+}
+
+func TestCompileVD(t *testing.T) {
+	gen := plugintest.New(t, "../testdata/vd.pb", "", "vd.proto")
+	var req *protogen.Message
+	for _, f := range gen.Files {
+		if f.Generate {
+			req = f.Messages[0]
+		}
+	}
+	fields := make(map[string]*protogen.Field)
+	for _, f := range req.Fields {
+		fields[string(f.Desc.Name())] = f
+	}
+	tests := []struct {
+		field  string
+		expr   string
+		annos  string
+		assert string
+		err    string
+	}{
+		{field: "age", expr: "$>=18", annos: "vt.ge=18"},
+		{field: "age", expr: "@:$>0; msg:'must be positive'", annos: "vt.gt=0"},
+		{field: "age", expr: "$!=3", annos: "vt.not_in=3"},
+		{field: "name", expr: "len($)<=10 && regexp('^a')", annos: "vt.max_size=10 vt.pattern=^a"},
+		{field: "name", expr: "mblen($)<=4", assert: "@le(@size($name), 4)"},
+		{field: "mode", expr: "in($, 'a', 'b')", annos: "vt.in=a,b"},
+		{field: "max", expr: "$>(Min)$", assert: "@gt($max, $min)"},
+		{field: "max", expr: "$>(min)$", assert: "@gt($max, $min)"},
+		{field: "age", expr: "$>0 && ($<10 || $>20)", annos: "vt.gt=0", assert: "@or(@lt($age, 10), @gt($age, 20))"},
+		{field: "ok", expr: "!$", assert: "@not($ok)"},
+		{field: "name", expr: "$", err: "the expression is a string instead of a bool"},
+		{field: "name", expr: "email($)", err: "function email is not supported at column 1"},
+		{field: "age", expr: "$%2==0", err: "operator % is not supported at column 2"},
+		{field: "name", expr: "(Nope)$!=''", err: "no field Nope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			annos, f, err := CompileVD(req, fields[tt.field], tt.expr)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("CompileVD() error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CompileVD() failed: %v", err)
+			}
+			var got []string
+			for _, a := range annos {
+				got = append(got, a.Key+"="+strings.Join(a.Values, ","))
+			}
+			if g := strings.Join(got, " "); g != tt.annos {
+				t.Errorf("CompileVD() rules = %q, want %q", g, tt.annos)
+			}
+			var assert string
+			if f != nil {
+				assert = f.String()
+			}
+			if assert != tt.assert {
+				t.Errorf("CompileVD() assert = %s, want %s", assert, tt.assert)
+			}
+		})
+	}
+}
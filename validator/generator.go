@@ -26,12 +26,13 @@ import (
 
 	"github.com/cloudwego/protoc-gen-validator/config"
 	"github.com/cloudwego/protoc-gen-validator/parser"
-	"github.com/cloudwego/protoc-gen-validator/util"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+const mathPackage = protogen.GoImportPath("math")
+
 type Generator struct {
 	*protogen.Plugin
 	*protogen.GeneratedFile
@@ -67,6 +68,9 @@ func (g *Generator) Generate() error {
 	g.generatePackage()
 	g.generateImportAndGuard()
 	err = g.generateValidate()
+	if err == nil {
+		err = g.generateServiceDispatchers()
+	}
 	g.generateFuncsImport()
 	if err != nil {
 		return err
@@ -121,7 +125,32 @@ func (g *Generator) generateValidate() error {
 		if err != nil {
 			return err
 		}
+		fieldMask := g.QualifiedGoIdent(vtPackage.Ident("FieldMask"))
+		ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
+		background := g.QualifiedGoIdent(contextPackage.Ident("Background"))
+		newFieldMask := g.QualifiedGoIdent(vtPackage.Ident("NewFieldMask"))
 		g.Pf("func (m *%s)Validate() error {", st.GoIdent.GoName)
+		g.Pf("return m.validateMask(%s(), nil)", background)
+		g.P("}")
+		g.P()
+		g.P("// ValidateWithContext is Validate passing ctx to the nested messages, the custom")
+		g.P("// functions, the hooks and the hand-written checks.")
+		g.Pf("func (m *%s) ValidateWithContext(ctx %s) error {", st.GoIdent.GoName, ctx)
+		g.P("return m.validateMask(ctx, nil)")
+		g.P("}")
+		g.P()
+		g.P("// ValidateFields validates the fields in the paths and their nested messages, the")
+		g.P("// message level rules are checked if the fields they refer to are in the paths.")
+		g.Pf("func (m *%s) ValidateFields(paths ...string) error {", st.GoIdent.GoName)
+		g.Pf("return m.validateMask(%s(), %s(paths...))", background, newFieldMask)
+		g.P("}")
+		g.P()
+		g.P("// ValidateFieldsWithContext is ValidateFields passing ctx like ValidateWithContext.")
+		g.Pf("func (m *%s) ValidateFieldsWithContext(ctx %s, paths ...string) error {", st.GoIdent.GoName, ctx)
+		g.Pf("return m.validateMask(ctx, %s(paths...))", newFieldMask)
+		g.P("}")
+		g.P()
+		g.Pf("func (m *%s) validateMask(ctx %s, mask *%s) error {", st.GoIdent.GoName, ctx, fieldMask)
 		for _, vc := range vcs {
 			switch vc.ValidationType {
 			case parser.StructLikeValidation:
@@ -135,14 +164,23 @@ func (g *Generator) generateValidate() error {
 				if len(vc.Rules) == 0 {
 					continue
 				}
+				g.Pf("if mask.Has(%s) {", strconv.Quote(vc.RawFieldName))
 				if err = g.generateFieldValidation(vc, false); err != nil {
 					return err
 				}
+				g.P("}")
 			}
 		}
+		// the hand-written checks may refer to any fields
+		g.P("if mask.Forced() {")
+		g.Pf("return %s(ctx, m)", g.QualifiedGoIdent(vtPackage.Ident("ValidateExtra")))
+		g.P("}")
 		g.P("return nil")
 		g.P("}")
 		g.P()
+		if err = g.generateNormalize(st); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -161,11 +199,35 @@ func (g *Generator) generatePackage() {
 	g.P()
 }
 
+// generateFieldValidation generates the checks of a field, which are guarded by the
+// when condition of the field if any.
 func (g *Generator) generateFieldValidation(vc *ValidateContext, isInnerType bool) error {
+	if vc.When == nil || isInnerType {
+		return g.generateFieldChecks(vc, isInnerType)
+	}
+	source := vc.GenID("_when")
+	if err := g.generateFunction(source, vc, vc.When); err != nil {
+		return err
+	}
+	g.Pf("if %s {", source)
+	if err := g.generateFieldChecks(vc, isInnerType); err != nil {
+		return err
+	}
+	g.P("}")
+	return nil
+}
+
+func (g *Generator) generateFieldChecks(vc *ValidateContext, isInnerType bool) error {
+	// the elements of lists and the values of maps are checked by the loop variable,
+	// only the messages of them can be nil
+	target, nilable := "m."+vc.FieldName, canBeNil(vc.RawField.Desc)
+	if isInnerType {
+		target, nilable = vc.GetNameFunc, vc.RawField.Desc.Kind() == protoreflect.MessageKind || vc.RawField.Desc.Kind() == protoreflect.GroupKind
+	}
 	for _, r := range vc.Rules {
-		if r.Key == parser.NotNil && r.Specified.TypedValue.Bool {
-			g.P(fmt.Sprintf("if m.%s == nil {", vc.FieldName))
-			g.P(fmt.Sprintf("return fmt.Errorf(\"field %s not_nil rule failed\")\n", vc.RawFieldName))
+		if r.Key == parser.NotNil && r.Specified.TypedValue.Bool && nilable {
+			g.Pf("if %s == nil {", target)
+			g.generateError(vc, r, "")
 			g.P("}")
 		}
 	}
@@ -197,7 +259,8 @@ func (g *Generator) generateEnumValidation(vc *ValidateContext) error {
 	for _, rule := range vc.Rules {
 		// construct target
 		target = vc.GetNameFunc
-		enumNameMap := util.CamelCase(string(vc.RawField.Desc.Enum().Name())) + "_name" // enumType_name is generated by protoc-gen-go
+		// enumType_name is generated by protoc-gen-go, the enum may be of another package
+		enumNameMap := g.QualifiedGoIdent(vc.RawField.Enum.GoIdent.GoImportPath.Ident(vc.RawField.Enum.GoIdent.GoName + "_name"))
 		// construct source
 		switch rule.Key {
 		case parser.Const:
@@ -210,6 +273,16 @@ func (g *Generator) generateEnumValidation(vc *ValidateContext) error {
 			}
 			source = vc.GenID("_src")
 			g.Pf("%s := %s", source, enumConst)
+		case parser.In, parser.NotIn:
+			var conds []string
+			for _, val := range rule.Range {
+				enumVal, err := g.getFieldEnumValue(val.TypedValue.Binary, vc)
+				if err != nil {
+					return err
+				}
+				conds = append(conds, target+" == "+enumVal)
+			}
+			source = strings.Join(conds, " || ")
 		case parser.DefinedOnly,
 			parser.NotNil:
 			// do nothing
@@ -220,14 +293,22 @@ func (g *Generator) generateEnumValidation(vc *ValidateContext) error {
 		switch rule.Key {
 		case parser.Const:
 			g.Pf("if %s != %s {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s const rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.DefinedOnly:
 			if rule.Specified.TypedValue.Bool {
 				g.Pf("if _, ok := %s[int32(%s)]; !ok {", enumNameMap, target)
-				g.Pf("return fmt.Errorf(\"field %s defined_only rule failed\")", vc.RawFieldName)
+				g.generateError(vc, rule, target)
 				g.P("}")
 			}
+		case parser.In:
+			g.Pf("if !(%s) {", source)
+			g.generateError(vc, rule, target)
+			g.P("}")
+		case parser.NotIn:
+			g.Pf("if %s {", source)
+			g.generateError(vc, rule, target)
+			g.P("}")
 		case parser.NotNil:
 			// do nothing
 		default:
@@ -250,6 +331,19 @@ func (g *Generator) getEnumValue(rule *parser.Rule, vc *ValidateContext) (string
 	}
 }
 
+// getFieldEnumValue returns the go identifier of a value of the enum of the field,
+// the value is written as Type.VALUE like const, and only its name is looked up.
+func (g *Generator) getFieldEnumValue(identifier string, vc *ValidateContext) (string, error) {
+	divId := strings.Split(identifier, ".")
+	name := divId[len(divId)-1]
+	for _, enumVal := range vc.RawField.Enum.Values {
+		if string(enumVal.Desc.Name()) == name {
+			return g.QualifiedGoIdent(enumVal.GoIdent), nil
+		}
+	}
+	return "", fmt.Errorf("can not find enum value '%s' in %s", identifier, vc.RawField.Enum.Desc.FullName())
+}
+
 func (g *Generator) getCurPackageEnumValue(divId []string, vc *ValidateContext) (string, error) {
 	curPackage := vc.PbFile.Proto.GetPackage()
 	for _, file := range g.Files {
@@ -306,13 +400,18 @@ func (g *Generator) generateBaseTypeValidation(vc *ValidateContext) error {
 
 func (g *Generator) generateNumericValidation(vc *ValidateContext) error {
 	var target, source, typeName string
+	isFloat := vc.RawField.Desc.Kind() == protoreflect.FloatKind || vc.RawField.Desc.Kind() == protoreflect.DoubleKind
+	// NaN fails every comparison, the rules of floats are negated to reject it
+	nanSafe := isFloat && !vc.AllowNaN
 	for _, rule := range vc.Rules {
 		// construct target
 		target = vc.GetNameFunc
 		typeName, _ = fieldGoType(g.GeneratedFile, vc.RawField)
+		// the elements of lists are validated one by one
+		typeName = strings.TrimPrefix(typeName, "[]")
 		// construct source
 		switch rule.Key {
-		case parser.Const, parser.LessThan, parser.LessEqual, parser.GreatThan, parser.GreatEqual:
+		case parser.Const, parser.LessThan, parser.LessEqual, parser.GreatThan, parser.GreatEqual, parser.MultipleOf:
 			vt := rule.Specified
 			switch vt.ValueType {
 			case parser.IntValue:
@@ -336,7 +435,7 @@ func (g *Generator) generateNumericValidation(vc *ValidateContext) error {
 			if err != nil {
 				return err
 			}
-		case parser.NotNil:
+		case parser.NotNil, parser.Finite:
 			// do nothing
 		default:
 			return errors.New("unknown numeric annotation")
@@ -344,25 +443,35 @@ func (g *Generator) generateNumericValidation(vc *ValidateContext) error {
 
 		switch rule.Key {
 		case parser.Const:
-			g.Pf("if %s != %s(%s) {", target, typeName, source)
-			g.Pf("return fmt.Errorf(\"field %s not match const value, current value: %%v\", %s)", vc.RawFieldName, target)
-			g.P("}")
-		case parser.LessThan:
-			g.Pf("if %s >= %s(%s) {", target, typeName, source)
-			g.Pf("return fmt.Errorf(\"field %s lt rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
-			g.P("}")
-		case parser.LessEqual:
-			g.Pf("if %s > %s(%s) {", target, typeName, source)
-			g.Pf("return fmt.Errorf(\"field %s le rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			if isFloat && vc.Epsilon > 0 {
+				g.Pf("if !(%s(float64(%s)-float64(%s)) <= %s) {", g.QualifiedGoIdent(mathPackage.Ident("Abs")),
+					target, source, strconv.FormatFloat(vc.Epsilon, 'g', -1, 64))
+			} else {
+				g.Pf("if %s != %s(%s) {", target, typeName, source)
+			}
+			g.generateError(vc, rule, target)
 			g.P("}")
-		case parser.GreatThan:
-			g.Pf("if %s <= %s(%s) {", target, typeName, source)
-			g.Pf("return fmt.Errorf(\"field %s gt rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+		case parser.LessThan, parser.LessEqual, parser.GreatThan, parser.GreatEqual:
+			op := map[parser.Key]string{parser.LessThan: "<", parser.LessEqual: "<=", parser.GreatThan: ">", parser.GreatEqual: ">="}[rule.Key]
+			if nanSafe {
+				g.Pf("if !(%s %s %s(%s)) {", target, op, typeName, source)
+			} else {
+				negated := map[string]string{"<": ">=", "<=": ">", ">": "<=", ">=": "<"}[op]
+				g.Pf("if %s %s %s(%s) {", target, negated, typeName, source)
+			}
+			g.generateError(vc, rule, target)
 			g.P("}")
-		case parser.GreatEqual:
-			g.Pf("if %s < %s(%s) {", target, typeName, source)
-			g.Pf("return fmt.Errorf(\"field %s ge rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+		case parser.MultipleOf:
+			g.Pf("if %s%%%s(%s) != 0 {", target, typeName, source)
+			g.generateError(vc, rule, target)
 			g.P("}")
+		case parser.Finite:
+			if rule.Specified.TypedValue.Bool {
+				g.Pf("if %s(float64(%s)) || %s(float64(%s), 0) {", g.QualifiedGoIdent(mathPackage.Ident("IsNaN")), target,
+					g.QualifiedGoIdent(mathPackage.Ident("IsInf")), target)
+				g.generateError(vc, rule, target)
+				g.P("}")
+			}
 		case parser.In:
 			exist := vc.GenID("_exist")
 			g.Pf("var %s bool", exist)
@@ -373,12 +482,12 @@ func (g *Generator) generateNumericValidation(vc *ValidateContext) error {
 			g.P("}")
 			g.P("}")
 			g.Pf("if !%s {", exist)
-			g.Pf("return fmt.Errorf(\"field %s in rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.NotIn:
 			g.Pf("for _, src := range %s {", source)
 			g.Pf("if %s == %s(src) {", target, typeName)
-			g.Pf("return fmt.Errorf(\"field %s not_in rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 			g.P("}")
 		case parser.NotNil:
@@ -436,7 +545,7 @@ func (g *Generator) generateSlice(name string, vc *ValidateContext, vals []*pars
 			if val.ValueType == parser.FieldReferenceValue {
 				source = val.TypedValue.GetFieldReferenceName("m.")
 			} else {
-				source = "\"" + val.TypedValue.Binary + "\""
+				source = goString(val.TypedValue.Binary)
 			}
 			vs = append(vs, goType+"("+source+")")
 		}
@@ -446,7 +555,7 @@ func (g *Generator) generateSlice(name string, vc *ValidateContext, vals []*pars
 			if val.ValueType == parser.FieldReferenceValue {
 				source = val.TypedValue.GetFieldReferenceName("m.")
 			} else {
-				source = "\"" + val.TypedValue.Binary + "\""
+				source = goString(val.TypedValue.Binary)
 			}
 			vs = append(vs, goType+"("+source+")")
 		}
@@ -482,7 +591,7 @@ func (g *Generator) generateBoolValidation(vc *ValidateContext) error {
 		switch rule.Key {
 		case parser.Const:
 			g.Pf("if %s != %s {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s const rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.NotNil:
 			// nothing
@@ -513,9 +622,9 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			default:
 				source = vc.GenID("_src")
 				if vc.RawField.Desc.Kind().String() == "string" || rule.Key == parser.Pattern {
-					g.P(source + " := \"" + vt.TypedValue.Binary + "\"")
+					g.P(source + " := " + goString(vt.TypedValue.Binary))
 				} else {
-					g.P(source + " := []byte(\"" + vt.TypedValue.Binary + "\")")
+					g.P(source + " := []byte(" + goString(vt.TypedValue.Binary) + ")")
 				}
 			}
 		case parser.MinSize, parser.MaxSize:
@@ -543,11 +652,11 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 		switch rule.Key {
 		case parser.MinSize:
 			g.Pf("if len(%s) < int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s min_len rule failed, current value: %%d\", len(%s))", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.MaxSize:
 			g.Pf("if len(%s) > int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s max_len rule failed, current value: %%d\", len(%s))", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.Const:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -555,7 +664,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if !bytes.Equal(%s, %s) {", target, source)
 			}
-			g.Pf("return fmt.Errorf(\"field %s not match const value, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.Prefix:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -563,7 +672,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if !bytes.HasPrefix(%s, %s) {", target, source)
 			}
-			g.Pf("return fmt.Errorf(\"field %s prefix rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.Suffix:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -571,7 +680,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if !bytes.HasSuffix(%s, %s) {", target, source)
 			}
-			g.Pf("return fmt.Errorf(\"field %s suffix rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.Contains:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -579,7 +688,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if !bytes.Contains(%s, %s) {", target, source)
 			}
-			g.Pf("return fmt.Errorf(\"field %s contains rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.NotContains:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -587,7 +696,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if bytes.Contains(%s, %s) {", target, source)
 			}
-			g.Pf("return fmt.Errorf(\"field %s not_contains rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.Pattern:
 			if vc.RawField.Desc.Kind().String() == "string" {
@@ -595,7 +704,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if ok, _ := regexp.Match(string(%s), %s); !ok {", source, target)
 			}
-			g.Pf("return fmt.Errorf(\"field %s pattern rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.In:
 			exist := vc.GenID("_exist")
@@ -611,7 +720,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			g.P("}")
 			g.P("}")
 			g.Pf("if !%s {", exist)
-			g.Pf("return fmt.Errorf(\"field %s in rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 		case parser.NotIn:
 			g.Pf("for _, src := range %s {", source)
@@ -620,7 +729,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 			} else {
 				g.Pf("if bytes.Equal(%s, src) {", target)
 			}
-			g.Pf("return fmt.Errorf(\"field %s not_in rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 			g.P("}")
 		case parser.NotNil:
@@ -633,7 +742,7 @@ func (g *Generator) generateBinaryValidation(vc *ValidateContext) error {
 }
 
 func (g *Generator) generateStructLikeFieldValidation(vc *ValidateContext) error {
-	var skip bool
+	var skip, notNil bool
 	for _, rule := range vc.Rules {
 		switch rule.Key {
 		case parser.Skip:
@@ -642,15 +751,29 @@ func (g *Generator) generateStructLikeFieldValidation(vc *ValidateContext) error
 				skip = true
 			}
 		case parser.NotNil:
-			// do nothing
+			notNil = rule.Specified.TypedValue.Bool
 		default:
 			return errors.New("unknown struct like annotation")
 		}
 	}
-	if !skip {
-		g.Pf("if err := %s.Validate(); err != nil {", vc.GetNameFunc)
-		g.Pf("return fmt.Errorf(\"filed %s not valid, %%w\", err)", vc.RawFieldName)
+	if !skip && !isWellKnownMessage(vc.RawField) {
+		// an unset message is valid unless not_nil is set, e.g. overridden by the field
+		if !notNil {
+			g.Pf("if %s != nil {", vc.GetNameFunc)
+		}
+		g.Pf("if err := %s(ctx, %s, mask.Sub(%s)); err != nil {", g.QualifiedGoIdent(vtPackage.Ident("ValidateMaskedContext")), vc.GetNameFunc, strconv.Quote(vc.RawFieldName))
+		switch {
+		case vc.ElemKey == "":
+			g.Pf("return %s(%s, err)", g.QualifiedGoIdent(vtPackage.Ident("Nested")), strconv.Quote(vc.RawFieldName))
+		case vc.RawField.Desc.IsList():
+			g.Pf("return %s(%s, %s, err)", g.QualifiedGoIdent(vtPackage.Ident("NestedIndex")), strconv.Quote(vc.RawFieldName), vc.ElemKey)
+		default:
+			g.Pf("return %s(%s, %s, err)", g.QualifiedGoIdent(vtPackage.Ident("NestedKey")), strconv.Quote(vc.RawFieldName), vc.ElemKey)
+		}
 		g.P("}")
+		if !notNil {
+			g.P("}")
+		}
 	}
 	return nil
 }
@@ -681,11 +804,11 @@ func (g *Generator) generateListValidation(vc *ValidateContext) error {
 		switch rule.Key {
 		case parser.MinSize:
 			g.Pf("if len(%s) < int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s MinLen rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.MaxSize:
 			g.Pf("if len(%s) > int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s MaxLen rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.Elem:
 			g.Pf("for i := 0; i < len(%s); i++ {", target)
@@ -697,10 +820,11 @@ func (g *Generator) generateListValidation(vc *ValidateContext) error {
 				RawField:     vc.RawField,
 				PbFile:       vc.PbFile,
 				FieldName:    elemName,
-				RawFieldName: elemName,
+				RawFieldName: vc.RawFieldName,
 				GetNameFunc:  elemName,
 				Msg:          vc.Msg,
 				Validation:   rule.Inner,
+				ElemKey:      "i",
 				ids:          vc.ids,
 			}
 			if err := g.generateFieldValidation(vt, true); err != nil {
@@ -748,11 +872,11 @@ func (g *Generator) generateMapValidation(vc *ValidateContext) error {
 		switch rule.Key {
 		case parser.MinSize:
 			g.Pf("if len(%s) < int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s min_size rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.MaxSize:
 			g.Pf("if len(%s) > int(%s) {", target, source)
-			g.Pf("return fmt.Errorf(\"field %s max_size rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, "len("+target+")")
 			g.P("}")
 		case parser.NoSparse:
 			if vc.RawField.Desc.MapValue().Kind() != protoreflect.MessageKind {
@@ -760,7 +884,7 @@ func (g *Generator) generateMapValidation(vc *ValidateContext) error {
 			}
 			g.Pf("for _, v := range %s {", target)
 			g.Pf("if v == nil {")
-			g.Pf("return fmt.Errorf(\"field %s no_sparse rule failed, current value: %%v\", %s)", vc.RawFieldName, target)
+			g.generateError(vc, rule, target)
 			g.P("}")
 			g.P("}")
 		case parser.MapKey:
@@ -791,24 +915,31 @@ func (g *Generator) generateMapValidation(vc *ValidateContext) error {
 
 			vt := &ValidateContext{
 				FieldName:    "k",
-				RawFieldName: "k",
+				RawFieldName: vc.RawFieldName,
 				GetNameFunc:  "k",
 				Validation:   rule.Inner,
 				ids:          vc.ids,
 				RawField:     keyField,
 				PbFile:       fileField,
-				Msg:          nil,
+				Msg:          vc.Msg,
 			}
 			if err := g.generateFieldValidation(vt, true); err != nil {
 				return err
 			}
 			g.P("}")
 		case parser.MapValue:
-			g.Pf("for _, v := range %s {", target)
 			// transfer map value field desc to protogen.Field
 			valueField := &protogen.Field{
 				Desc: vc.RawField.Desc.MapValue(),
 			}
+			// the key is only used by the violations of the nested messages
+			var elemKey string
+			if validatesNested(valueField, rule.Inner) {
+				elemKey = "k"
+				g.Pf("for k, v := range %s {", target)
+			} else {
+				g.Pf("for _, v := range %s {", target)
+			}
 			if vc.RawField.Desc.MapValue().Kind() == protoreflect.EnumKind {
 				enumDes, err := g.getEnumEnumDescriptorProto(vc.RawField)
 				if err != nil {
@@ -839,13 +970,14 @@ func (g *Generator) generateMapValidation(vc *ValidateContext) error {
 
 			vt := &ValidateContext{
 				FieldName:    "v",
-				RawFieldName: "v",
+				RawFieldName: vc.RawFieldName,
 				GetNameFunc:  "v",
 				Validation:   rule.Inner,
+				ElemKey:      elemKey,
 				ids:          vc.ids,
 				RawField:     valueField,
 				PbFile:       fileField,
-				Msg:          nil,
+				Msg:          vc.Msg,
 			}
 			if err := g.generateFieldValidation(vt, true); err != nil {
 				return err
@@ -893,15 +1025,67 @@ func (g *Generator) generateFunction(source string, vc *ValidateContext, f *pars
 		for _, arg := range f.Arguments {
 			switch arg.ValueType {
 			case parser.BinaryValue:
-				args = append(args, "\""+arg.TypedValue.Binary+"\"")
+				args = append(args, goString(arg.TypedValue.Binary))
 			case parser.FieldReferenceValue:
 				args = append(args, arg.TypedValue.GetFieldReferenceName("m."))
 			}
 		}
 		str.WriteString(strings.Join(args, ",") + ")")
 		g.P(str.String())
+	case "size", "has":
+		if len(f.Arguments) != 1 || f.Arguments[0].ValueType != parser.FieldReferenceValue {
+			return fmt.Errorf("function %s needs a field reference argument", f.Name)
+		}
+		ref := f.Arguments[0].TypedValue
+		if f.Name == "has" {
+			g.Pf(source+" := m.ProtoReflect().Has(m.ProtoReflect().Descriptor().Fields().ByNumber(%d))", ref.FieldReference.Desc.Number())
+			break
+		}
+		if ref.FieldReference.Desc.Kind() == protoreflect.StringKind && !ref.FieldReference.Desc.IsList() && !ref.FieldReference.Desc.IsMap() {
+			runeCount := g.QualifiedGoIdent(protogen.GoIdent{GoName: "RuneCountInString", GoImportPath: "unicode/utf8"})
+			g.Pf(source+" := %s(%s)", runeCount, ref.GetFieldReferenceName("m."))
+		} else {
+			g.Pf(source+" := len(%s)", ref.GetFieldReferenceName("m."))
+		}
+	case "and", "or", "not":
+		if len(f.Arguments) == 0 {
+			return fmt.Errorf("function %s needs at least 1 argument", f.Name)
+		}
+		var args []string
+		for i := range f.Arguments {
+			arg, err := g.renderBool(vc, &f.Arguments[i])
+			if err != nil {
+				return err
+			}
+			args = append(args, arg)
+		}
+		switch f.Name {
+		case "and":
+			g.P(source + " := " + strings.Join(args, " && "))
+		case "or":
+			g.P(source + " := " + strings.Join(args, " || "))
+		default:
+			if len(args) != 1 {
+				return errors.New("function not needs 1 argument")
+			}
+			g.P(source + " := !" + args[0])
+		}
+	case "equal", "ne", "lt", "le", "gt", "ge":
+		if len(f.Arguments) < 2 {
+			return fmt.Errorf("binary function %s needs at least 2 arguments", f.Name)
+		}
+		a, err := g.renderComparable(vc, &f.Arguments[0])
+		if err != nil {
+			return err
+		}
+		b, err := g.renderComparable(vc, &f.Arguments[1])
+		if err != nil {
+			return err
+		}
+		op := map[string]string{"equal": "==", "ne": "!=", "lt": "<", "le": "<=", "gt": ">", "ge": ">="}[f.Name]
+		g.P(source + " := " + a + " " + op + " " + b)
 	// binary function
-	case "equal", "mod", "add":
+	case "mod", "add":
 		var args []string
 		str := strings.Builder{}
 		for _, arg := range f.Arguments {
@@ -916,8 +1100,6 @@ func (g *Generator) generateFunction(source string, vc *ValidateContext, f *pars
 		}
 		str.WriteString(source + " := " + args[0])
 		switch f.Name {
-		case "equal":
-			str.WriteString(" == ")
 		case "mod":
 			str.WriteString(" % ")
 		case "add":
@@ -926,7 +1108,8 @@ func (g *Generator) generateFunction(source string, vc *ValidateContext, f *pars
 		str.WriteString(args[1])
 		g.P(str.String())
 	case "now_unix_nano":
-		g.Pf(source + ":= time.Now().UnixNano()")
+		// the clock can be injected by vt.WithClock
+		g.Pf("%s := %s(ctx).UnixNano()", source, g.QualifiedGoIdent(vtPackage.Ident("Now")))
 		return nil
 	default:
 		funcTemplate := g.config.GetFunction(f.Name)
@@ -936,10 +1119,12 @@ func (g *Generator) generateFunction(source string, vc *ValidateContext, f *pars
 		var buf bytes.Buffer
 		err := funcTemplate.Execute(&buf, &struct {
 			Source     string
+			Context    string
 			StructLike *protogen.File
 			Function   *parser.ToolFunction
 		}{
 			Source:     source,
+			Context:    "ctx",
 			StructLike: vc.PbFile,
 			Function:   f,
 		})
@@ -969,6 +1154,77 @@ func (g *Generator) renderValidationValue(vc *ValidateContext, val *parser.Valid
 	}
 }
 
+// renderComparable renders an operand of a comparison, the numbers are converted
+// to int64, uint64 or float64 and the literals are left untyped.
+func (g *Generator) renderComparable(vc *ValidateContext, val *parser.ValidationValue) (string, error) {
+	switch val.ValueType {
+	case parser.IntValue:
+		return strconv.FormatInt(val.TypedValue.Int, 10), nil
+	case parser.DoubleValue:
+		return strconv.FormatFloat(val.TypedValue.Double, 'g', -1, 64), nil
+	case parser.BoolValue:
+		return strconv.FormatBool(val.TypedValue.Bool), nil
+	case parser.BinaryValue:
+		return strconv.Quote(val.TypedValue.Binary), nil
+	case parser.EnumValue:
+		return strconv.FormatInt(val.TypedValue.Int, 10), nil
+	case parser.FunctionValue:
+		source := vc.GenID("_src")
+		if err := g.generateFunction(source, vc, val.TypedValue.Function); err != nil {
+			return "", err
+		}
+		switch val.TypedValue.Function.Name {
+		case "len", "size":
+			return "int64(" + source + ")", nil
+		}
+		return source, nil
+	case parser.FieldReferenceValue:
+		fd := val.TypedValue.FieldReference.Desc
+		getter := val.TypedValue.GetFieldReferenceName("m.")
+		if fd.IsList() || fd.IsMap() {
+			return "", fmt.Errorf("field %s can not be compared", fd.Name())
+		}
+		switch fd.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.EnumKind:
+			return "int64(" + getter + ")", nil
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			return "uint64(" + getter + ")", nil
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			return "float64(" + getter + ")", nil
+		case protoreflect.BytesKind:
+			return "string(" + getter + ")", nil
+		case protoreflect.StringKind, protoreflect.BoolKind:
+			return getter, nil
+		default:
+			return "", fmt.Errorf("field %s can not be compared", fd.Name())
+		}
+	default:
+		return "", fmt.Errorf("value type %s can not be compared", val.ValueType)
+	}
+}
+
+// renderBool renders an operand of the logical functions.
+func (g *Generator) renderBool(vc *ValidateContext, val *parser.ValidationValue) (string, error) {
+	switch val.ValueType {
+	case parser.BoolValue:
+		return strconv.FormatBool(val.TypedValue.Bool), nil
+	case parser.FunctionValue:
+		source := vc.GenID("_src")
+		if err := g.generateFunction(source, vc, val.TypedValue.Function); err != nil {
+			return "", err
+		}
+		return source, nil
+	case parser.FieldReferenceValue:
+		if fd := val.TypedValue.FieldReference.Desc; fd.Kind() != protoreflect.BoolKind || fd.IsList() || fd.IsMap() {
+			return "", fmt.Errorf("field %s is not a bool", fd.Name())
+		}
+		return val.TypedValue.GetFieldReferenceName("m."), nil
+	default:
+		return "", fmt.Errorf("value type %s is not a bool", val.ValueType)
+	}
+}
+
 func (g *Generator) generateFuncsImport() {
 	var importBuf bytes.Buffer
 	for tpl := range g.usedFuncs {
@@ -997,13 +1253,38 @@ func (g *Generator) generateStructLikeValidation(vc *ValidateContext) error {
 	for _, rule := range vc.Rules {
 		switch rule.Key {
 		case parser.Assert:
+			// the assertion is checked if all the fields it refers to are in the mask
+			refs := referredFields(rule.Specified.TypedValue.Function)
+			if len(refs) > 0 {
+				var has []string
+				for _, name := range refs {
+					has = append(has, fmt.Sprintf("mask.Has(%s)", strconv.Quote(name)))
+				}
+				g.Pf("if mask.Forced() || %s {", strings.Join(has, " && "))
+			}
 			source := vc.GenID("_assert")
 			err := g.generateFunction(source, vc, rule.Specified.TypedValue.Function)
 			if err != nil {
 				return err
 			}
 			g.Pf("if !(" + source + ") {")
-			g.P("return fmt.Errorf(\"struct assertion failed\")")
+			g.generateError(vc, rule, "")
+			g.P("}")
+			if len(refs) > 0 {
+				g.P("}")
+			}
+		case parser.OneofRequired:
+			oneof := findOneof(vc.Msg, rule.Specified.TypedValue.Binary)
+			if oneof == nil {
+				return fmt.Errorf("oneof %s not found", rule.Specified.TypedValue.Binary)
+			}
+			// the oneof is checked if any of its fields is in the mask
+			var has []string
+			for _, f := range oneof.Fields {
+				has = append(has, fmt.Sprintf("mask.Has(%s)", strconv.Quote(string(f.Desc.Name()))))
+			}
+			g.Pf("if (mask.Forced() || %s) && m.%s == nil {", strings.Join(has, " || "), oneof.GoName)
+			g.generateError(vc, rule, "")
 			g.P("}")
 		default:
 			return errors.New("unknown struct like annotation")
@@ -1011,3 +1292,70 @@ func (g *Generator) generateStructLikeValidation(vc *ValidateContext) error {
 	}
 	return nil
 }
+
+// goString returns s as a go string literal. The binary values of vt are written
+// as the content of a go string literal, the ones that are not valid, such as
+// raw patterns, are quoted.
+func goString(s string) string {
+	if _, err := strconv.Unquote("\"" + s + "\""); err == nil {
+		return "\"" + s + "\""
+	}
+	return strconv.Quote(s)
+}
+
+// referredFields returns the names of the fields referred to by the function and
+// its arguments.
+func referredFields(f *parser.ToolFunction) []string {
+	var ret []string
+	seen := map[string]bool{}
+	var walk func(f *parser.ToolFunction)
+	walk = func(f *parser.ToolFunction) {
+		for _, arg := range f.Arguments {
+			switch arg.ValueType {
+			case parser.FieldReferenceValue:
+				name := string(arg.TypedValue.FieldReference.Desc.Name())
+				if !seen[name] {
+					seen[name] = true
+					ret = append(ret, name)
+				}
+			case parser.FunctionValue:
+				walk(arg.TypedValue.Function)
+			}
+		}
+	}
+	walk(f)
+	return ret
+}
+
+func findOneof(msg *protogen.Message, name string) *protogen.Oneof {
+	for _, oneof := range msg.Oneofs {
+		if string(oneof.Desc.Name()) == name {
+			return oneof
+		}
+	}
+	return nil
+}
+
+// isWellKnownMessage reports whether the message of f, or the values of a map f,
+// is a well-known type, which has no Validate method.
+func isWellKnownMessage(f *protogen.Field) bool {
+	md := f.Desc.Message()
+	if md != nil && md.IsMapEntry() {
+		md = md.Fields().ByNumber(2).Message()
+	}
+	return md != nil && md.ParentFile().Package() == "google.protobuf"
+}
+
+// validatesNested reports whether the nested message of f is validated with the
+// rules of v.
+func validatesNested(f *protogen.Field, v *parser.Validation) bool {
+	if f.Desc.Kind() != protoreflect.MessageKind || isWellKnownMessage(f) {
+		return false
+	}
+	for _, rule := range v.Rules {
+		if rule.Key == parser.Skip && rule.Specified.TypedValue.Bool {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   string
+		file  string
+		param string
+	}{
+		{"vt", "../testdata/vt.pb", "vt.proto", ""},
+		{"pgv", "../testdata/pgv.pb", "pgv.proto", ""},
+		{"protovalidate", "../testdata/protovalidate.pb", "protovalidate.proto", ""},
+		{"vd", "../testdata/vd.pb", "vd.proto", ""},
+		{"hz", "../testdata/hz.pb", "hz.proto", ""},
+		{"run", "../testdata/run.pb", "run.proto", ""},
+		{"hooks", "../testdata/vt.pb", "vt.proto", "hooks=true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen := plugintest.New(t, tt.set, tt.param, tt.file)
+			for _, f := range gen.Files {
+				if !f.Generate {
+					continue
+				}
+				g, err := NewGenerator(gen, f)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := g.Generate(); err != nil {
+					t.Fatal(err)
+				}
+				if err := g.GenerateKitexMiddleware(); err != nil {
+					t.Fatal(err)
+				}
+				if len(f.Services) > 0 {
+					if err := g.GenerateHertzBinding(); err != nil {
+						t.Fatal(err)
+					}
+					if err := g.GenerateGRPCInterceptors(f.Services); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			dir := "testdata"
+			if tt.param != "" {
+				dir = filepath.Join(dir, tt.name)
+			}
+			plugintest.Golden(t, gen, dir)
+		})
+	}
+}
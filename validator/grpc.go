@@ -0,0 +1,199 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const (
+	grpcPackage       = protogen.GoImportPath("google.golang.org/grpc")
+	grpcCodesPackage  = protogen.GoImportPath("google.golang.org/grpc/codes")
+	grpcStatusPackage = protogen.GoImportPath("google.golang.org/grpc/status")
+	errdetailsPackage = protogen.GoImportPath("google.golang.org/genproto/googleapis/rpc/errdetails")
+	stringsPackage    = protogen.GoImportPath("strings")
+)
+
+// GenerateGRPCInterceptors generates the grpc-go interceptors that call Validate on
+// the messages received by the servers and sent by the clients. The interceptors
+// work for the messages of any package, they're generated once in a package. The
+// methods of services, which are the services of the package, are validated by
+// their dispatchers, so that the method_vt options are honored.
+func (g *Generator) GenerateGRPCInterceptors(services []*protogen.Service) error {
+	g.GeneratedFile = g.NewGeneratedFile(g.PbFile.GeneratedFilenamePrefix+"_validate_grpc.pb.go", g.PbFile.GoImportPath)
+	g.generateHeader()
+	g.generatePackage()
+
+	ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
+	callOption := g.QualifiedGoIdent(grpcPackage.Ident("CallOption"))
+
+	g.P("// ValidateUnaryServerInterceptor returns a unary server interceptor that rejects")
+	g.P("// the invalid requests with codes.InvalidArgument before the handlers, and the")
+	g.P("// invalid responses with codes.Internal if method_vt.response of the method is set.")
+	g.Pf("func ValidateUnaryServerInterceptor() %s {", g.QualifiedGoIdent(grpcPackage.Ident("UnaryServerInterceptor")))
+	g.Pf("return func(ctx %s, req interface{}, info *%s, handler %s) (interface{}, error) {",
+		ctx, g.QualifiedGoIdent(grpcPackage.Ident("UnaryServerInfo")), g.QualifiedGoIdent(grpcPackage.Ident("UnaryHandler")))
+	g.P("if err := validateRequest(ctx, info.FullMethod, req); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("resp, err := handler(ctx, req)")
+	g.P("if err != nil {")
+	g.P("return resp, err")
+	g.P("}")
+	g.P("if err = validateResponse(ctx, info.FullMethod, resp); err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return resp, nil")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("// ValidateStreamServerInterceptor returns a stream server interceptor that rejects")
+	g.P("// the invalid messages received from the clients with codes.InvalidArgument, and")
+	g.P("// the invalid messages sent with codes.Internal if method_vt.response is set.")
+	g.Pf("func ValidateStreamServerInterceptor() %s {", g.QualifiedGoIdent(grpcPackage.Ident("StreamServerInterceptor")))
+	g.Pf("return func(srv interface{}, ss %s, info *%s, handler %s) error {",
+		g.QualifiedGoIdent(grpcPackage.Ident("ServerStream")), g.QualifiedGoIdent(grpcPackage.Ident("StreamServerInfo")), g.QualifiedGoIdent(grpcPackage.Ident("StreamHandler")))
+	g.P("return handler(srv, &validateServerStream{ss, info.FullMethod})")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("// ValidateUnaryClientInterceptor returns a unary client interceptor that rejects")
+	g.P("// the invalid requests with codes.InvalidArgument before sending them.")
+	g.Pf("func ValidateUnaryClientInterceptor() %s {", g.QualifiedGoIdent(grpcPackage.Ident("UnaryClientInterceptor")))
+	g.Pf("return func(ctx %s, method string, req, reply interface{}, cc *%s, invoker %s, opts ...%s) error {",
+		ctx, g.QualifiedGoIdent(grpcPackage.Ident("ClientConn")), g.QualifiedGoIdent(grpcPackage.Ident("UnaryInvoker")), callOption)
+	g.P("if err := validateRequest(ctx, method, req); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return invoker(ctx, method, req, reply, cc, opts...)")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("// ValidateStreamClientInterceptor returns a stream client interceptor that rejects")
+	g.P("// the invalid messages with codes.InvalidArgument before sending them.")
+	g.Pf("func ValidateStreamClientInterceptor() %s {", g.QualifiedGoIdent(grpcPackage.Ident("StreamClientInterceptor")))
+	g.Pf("return func(ctx %s, desc *%s, cc *%s, method string, streamer %s, opts ...%s) (%s, error) {",
+		ctx, g.QualifiedGoIdent(grpcPackage.Ident("StreamDesc")), g.QualifiedGoIdent(grpcPackage.Ident("ClientConn")),
+		g.QualifiedGoIdent(grpcPackage.Ident("Streamer")), callOption, g.QualifiedGoIdent(grpcPackage.Ident("ClientStream")))
+	g.P("cs, err := streamer(ctx, desc, cc, method, opts...)")
+	g.P("if err != nil {")
+	g.P("return nil, err")
+	g.P("}")
+	g.P("return &validateClientStream{cs, method}, nil")
+	g.P("}")
+	g.P("}")
+	g.P()
+	g.P("type validateServerStream struct {")
+	g.P(g.QualifiedGoIdent(grpcPackage.Ident("ServerStream")))
+	g.P("method string")
+	g.P("}")
+	g.P()
+	g.P("func (s *validateServerStream) RecvMsg(m interface{}) error {")
+	g.P("if err := s.ServerStream.RecvMsg(m); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return validateRequest(s.Context(), s.method, m)")
+	g.P("}")
+	g.P()
+	g.P("func (s *validateServerStream) SendMsg(m interface{}) error {")
+	g.P("if err := validateResponse(s.Context(), s.method, m); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return s.ServerStream.SendMsg(m)")
+	g.P("}")
+	g.P()
+	g.P("type validateClientStream struct {")
+	g.P(g.QualifiedGoIdent(grpcPackage.Ident("ClientStream")))
+	g.P("method string")
+	g.P("}")
+	g.P()
+	g.P("func (s *validateClientStream) SendMsg(m interface{}) error {")
+	g.P("if err := validateRequest(s.Context(), s.method, m); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("return s.ClientStream.SendMsg(m)")
+	g.P("}")
+	g.P()
+	g.generateGRPCDispatchers(services)
+	g.P("// validateRequest validates the request of the full method by the dispatcher of its")
+	g.P("// service, or calls ValidateWithContext or Validate of the request of the services")
+	g.P("// of other packages.")
+	g.Pf("func validateRequest(ctx %s, method string, req interface{}) error {", ctx)
+	g.P("if d, ok := validateDispatchers[validateService(method)]; ok {")
+	g.Pf("return validateStatus(%s, d.ValidateRequestWithContext(ctx, method, req))", g.QualifiedGoIdent(grpcCodesPackage.Ident("InvalidArgument")))
+	g.P("}")
+	g.Pf("return validateStatus(%s, %s(ctx, req))", g.QualifiedGoIdent(grpcCodesPackage.Ident("InvalidArgument")), g.QualifiedGoIdent(vtPackage.Ident("ValidateWithContext")))
+	g.P("}")
+	g.P()
+	g.P("// validateResponse validates the response of the full method if method_vt.response")
+	g.P("// of the method is set.")
+	g.Pf("func validateResponse(ctx %s, method string, resp interface{}) error {", ctx)
+	g.P("if d, ok := validateDispatchers[validateService(method)]; ok {")
+	g.Pf("return validateStatus(%s, d.ValidateResponseWithContext(ctx, method, resp))", g.QualifiedGoIdent(grpcCodesPackage.Ident("Internal")))
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	g.P("// validateService returns the service of a full method, such as /pkg.Service/Method.")
+	g.P("func validateService(method string) string {")
+	g.Pf("if i := %s(method, \"/\"); i > 0 {", g.QualifiedGoIdent(stringsPackage.Ident("LastIndex")))
+	g.P("return method[1:i]")
+	g.P("}")
+	g.P("return \"\"")
+	g.P("}")
+	g.P()
+	g.P("// validateStatus converts the error of Validate to a status of the code with the")
+	g.P("// invalid field in the details.")
+	g.Pf("func validateStatus(code %s, err error) error {", g.QualifiedGoIdent(grpcCodesPackage.Ident("Code")))
+	g.P("if err == nil {")
+	g.P("return nil")
+	g.P("}")
+	g.P("ve := NewValidateError(err)")
+	g.Pf("st := %s(code, err.Error())", g.QualifiedGoIdent(grpcStatusPackage.Ident("New")))
+	g.Pf("ds, derr := st.WithDetails(&%s{", g.QualifiedGoIdent(errdetailsPackage.Ident("BadRequest")))
+	g.Pf("FieldViolations: []*%s{{", g.QualifiedGoIdent(errdetailsPackage.Ident("BadRequest_FieldViolation")))
+	g.P("Field:       ve.Field,")
+	g.Pf("Description: %s(err, %s),", g.QualifiedGoIdent(vtPackage.Ident("Localize")), g.QualifiedGoIdent(vtPackage.Ident("DefaultLocale")))
+	g.P("}},")
+	g.P("})")
+	g.P("if derr != nil {")
+	g.P("return st.Err()")
+	g.P("}")
+	g.P("return ds.Err()")
+	g.P("}")
+	g.P()
+	g.generateValidateError()
+	return nil
+}
+
+// generateGRPCDispatchers generates the dispatchers of the services by their full
+// names, the validating methods are looked up by the full methods of grpc.
+func (g *Generator) generateGRPCDispatchers(services []*protogen.Service) {
+	g.P("// validateDispatcher validates the messages of a method by its method_vt options.")
+	g.P("type validateDispatcher interface {")
+	g.Pf("ValidateRequestWithContext(ctx %s, method string, req interface{}) error", g.QualifiedGoIdent(contextPackage.Ident("Context")))
+	g.Pf("ValidateResponseWithContext(ctx %s, method string, resp interface{}) error", g.QualifiedGoIdent(contextPackage.Ident("Context")))
+	g.P("}")
+	g.P()
+	g.P("// validateDispatchers are the dispatchers of the services of the package.")
+	g.P("var validateDispatchers = map[string]validateDispatcher{")
+	for _, s := range services {
+		g.Pf("%s: %sValidateDispatcher{},", strconv.Quote(string(s.Desc.FullName())), s.GoName)
+	}
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const (
+	hertzAppPackage     = protogen.GoImportPath("github.com/cloudwego/hertz/pkg/app")
+	hertzServerPackage  = protogen.GoImportPath("github.com/cloudwego/hertz/pkg/app/server")
+	hertzBindingPackage = protogen.GoImportPath("github.com/cloudwego/hertz/pkg/app/server/binding")
+	hertzConfigPackage  = protogen.GoImportPath("github.com/cloudwego/hertz/pkg/common/config")
+	hertzConstsPackage  = protogen.GoImportPath("github.com/cloudwego/hertz/pkg/protocol/consts")
+)
+
+// GenerateHertzBinding generates a hertz struct validator calling Validate, so that
+// the BindAndValidate of hertz runs the generated rules instead of the vd tags. The
+// validator works for the messages of any package, it's generated once in a package
+// by the adopter.
+func (g *Generator) GenerateHertzBinding() error {
+	g.GeneratedFile = g.NewGeneratedFile(g.PbFile.GeneratedFilenamePrefix+"_validate_binding.pb.go", g.PbFile.GoImportPath)
+	g.generateHeader()
+	g.generatePackage()
+
+	g.P("// HertzValidator is a hertz binding.StructValidator that calls the Validate method")
+	g.P("// generated for the messages, the objects without Validate are accepted.")
+	g.P("type HertzValidator struct{}")
+	g.P()
+	g.Pf("var _ %s = HertzValidator{}", g.QualifiedGoIdent(hertzBindingPackage.Ident("StructValidator")))
+	g.P()
+	g.P("// WithHertzValidator returns the server option registering HertzValidator.")
+	g.Pf("func WithHertzValidator() %s {", g.QualifiedGoIdent(hertzConfigPackage.Ident("Option")))
+	g.Pf("return %s(HertzValidator{})", g.QualifiedGoIdent(hertzServerPackage.Ident("WithCustomValidator")))
+	g.P("}")
+	g.P()
+	g.P("// ValidateStruct calls Validate of obj, the error is a *ValidateError.")
+	g.P("func (HertzValidator) ValidateStruct(obj interface{}) error {")
+	g.P("v, ok := obj.(interface{ Validate() error })")
+	g.P("if !ok {")
+	g.P("return nil")
+	g.P("}")
+	g.P("if err := v.Validate(); err != nil {")
+	g.P("return NewValidateError(err)")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	g.P("// Engine returns nil since there is no underlying validation engine.")
+	g.P("func (HertzValidator) Engine() interface{} {")
+	g.P("return nil")
+	g.P("}")
+	g.P()
+	g.P("// ValidateTag returns the tag of the default validator of hertz.")
+	g.P("func (HertzValidator) ValidateTag() string {")
+	g.P(`return "vd"`)
+	g.P("}")
+	g.P()
+	g.generateValidateError()
+	g.P("// AbortWithValidateError responds 400 with the field path and the message of a")
+	g.P("// *ValidateError returned by BindAndValidate, and reports whether err is one. The")
+	g.P("// message is localized by the Accept-Language header of the request.")
+	g.Pf("func AbortWithValidateError(c *%s, err error) bool {", g.QualifiedGoIdent(hertzAppPackage.Ident("RequestContext")))
+	g.P("var ve *ValidateError")
+	g.Pf("if !%s(err, &ve) {", g.QualifiedGoIdent(errorsPackage.Ident("As")))
+	g.P("return false")
+	g.P("}")
+	g.Pf("c.AbortWithStatusJSON(%s, map[string]string{", g.QualifiedGoIdent(hertzConstsPackage.Ident("StatusBadRequest")))
+	g.P(`"field":   ve.Field,`)
+	g.Pf(`"message": %s(ve.Err, string(c.GetHeader("Accept-Language"))),`, g.QualifiedGoIdent(vtPackage.Ident("Localize")))
+	g.P("})")
+	g.P("return true")
+	g.P("}")
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const (
+	kitexEndpointPackage = protogen.GoImportPath("github.com/cloudwego/kitex/pkg/endpoint")
+	kitexRPCInfoPackage  = protogen.GoImportPath("github.com/cloudwego/kitex/pkg/rpcinfo")
+	contextPackage       = protogen.GoImportPath("context")
+	reflectPackage       = protogen.GoImportPath("reflect")
+)
+
+// GenerateKitexMiddleware generates a kitex server middleware for each service of
+// the file, which calls Validate on the requests before the handlers. The args and
+// results of kitex are accessed by GetFirstArgument and GetResult, so the service
+// packages of kitex_gen are not imported.
+func (g *Generator) GenerateKitexMiddleware() error {
+	if len(g.PbFile.Services) == 0 {
+		return nil
+	}
+	g.GeneratedFile = g.NewGeneratedFile(g.PbFile.GeneratedFilenamePrefix+"_validate_middleware.pb.go", g.PbFile.GoImportPath)
+	g.generateHeader()
+	g.generatePackage()
+	for _, s := range g.PbFile.Services {
+		g.generateServiceMiddleware(s)
+	}
+	return nil
+}
+
+func (g *Generator) generateServiceMiddleware(s *protogen.Service) {
+	ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
+	endpoint := g.QualifiedGoIdent(kitexEndpointPackage.Ident("Endpoint"))
+	opts := s.GoName + "ValidateOptions"
+
+	g.Pf("// %s configures the middleware returned by New%sValidateMiddleware.", opts, s.GoName)
+	g.Pf("type %s struct {", opts)
+	g.P("// ValidateResponse validates the responses of all the methods, including the ones")
+	g.P("// without the response of method_vt.")
+	g.P("ValidateResponse bool")
+	g.P("// OnInvalidRequest returns the error of an invalid request, which is the error")
+	g.P("// of Validate if it's nil.")
+	g.Pf("OnInvalidRequest func(ctx %s, err error) error", ctx)
+	g.P("// OnInvalidResponse returns the error of an invalid response, which is the error")
+	g.P("// of Validate if it's nil.")
+	g.Pf("OnInvalidResponse func(ctx %s, err error) error", ctx)
+	g.P("}")
+	g.P()
+	g.Pf("// New%sValidateMiddleware returns a kitex server middleware that calls Validate", s.GoName)
+	g.Pf("// on the requests of the service %s, the invalid requests are rejected before", s.Desc.Name())
+	g.Pf("// the handlers. The method_vt options are honored by %sValidateDispatcher.", s.GoName)
+	g.Pf("func New%sValidateMiddleware(opts %s) %s {", s.GoName, opts, g.QualifiedGoIdent(kitexEndpointPackage.Ident("Middleware")))
+	g.Pf("var d %sValidateDispatcher", s.GoName)
+	g.P("methods := map[string]bool{")
+	for _, m := range s.Methods {
+		if m.Desc.IsStreamingClient() || m.Desc.IsStreamingServer() {
+			continue
+		}
+		g.Pf("%s: true,", strconv.Quote(string(m.Desc.Name())))
+	}
+	g.P("}")
+	g.Pf("return func(next %s) %s {", endpoint, endpoint)
+	g.Pf("return func(ctx %s, req, resp interface{}) error {", ctx)
+	g.Pf("ri := %s(ctx)", g.QualifiedGoIdent(kitexRPCInfoPackage.Ident("GetRPCInfo")))
+	g.P("if ri == nil || ri.To() == nil || !methods[ri.To().Method()] {")
+	g.P("return next(ctx, req, resp)")
+	g.P("}")
+	g.P("method := ri.To().Method()")
+	g.P("if args, ok := req.(interface{ GetFirstArgument() interface{} }); ok {")
+	g.P("if err := d.ValidateRequestWithContext(ctx, method, args.GetFirstArgument()); err != nil {")
+	g.P("if opts.OnInvalidRequest != nil {")
+	g.P("return opts.OnInvalidRequest(ctx, err)")
+	g.P("}")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("if err := next(ctx, req, resp); err != nil {")
+	g.P("return err")
+	g.P("}")
+	g.P("if result, ok := resp.(interface{ GetResult() interface{} }); ok {")
+	g.P("err := d.ValidateResponseWithContext(ctx, method, result.GetResult())")
+	g.P("// a handler may return a nil response")
+	g.Pf("if v, ok := result.GetResult().(interface{ Validate() error }); ok && opts.ValidateResponse && !%s(v).IsNil() {", g.QualifiedGoIdent(reflectPackage.Ident("ValueOf")))
+	g.Pf("err = %s(ctx, v)", g.QualifiedGoIdent(vtPackage.Ident("ValidateWithContext")))
+	g.P("}")
+	g.P("if err != nil {")
+	g.P("if opts.OnInvalidResponse != nil {")
+	g.P("return opts.OnInvalidResponse(ctx, err)")
+	g.P("}")
+	g.P("return err")
+	g.P("}")
+	g.P("}")
+	g.P("return nil")
+	g.P("}")
+	g.P("}")
+	g.P("}")
+	g.P()
+}
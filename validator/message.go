@@ -0,0 +1,106 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const vtPackage = protogen.GoImportPath("github.com/cloudwego/protoc-gen-validator/vt")
+
+// generateError generates the return of the *vt.Violation of a failed rule, value is
+// the expression of the current value or empty if there is none. The message is
+// resolved at runtime by the id of the rule, or by the msg of the rule if specified.
+// The violations of the warn rules are passed to vt.Report instead, and
+// vt.ObserveContext is called first with the hooks parameter.
+func (g *Generator) generateError(vc *ValidateContext, rule *parser.Rule, value string) {
+	name := vc.RawFieldName
+	if vc.RawField == nil && vc.Msg != nil {
+		// the message level rules
+		name = string(vc.Msg.Desc.Name())
+	}
+	if g.config.GetHooks() {
+		var field string
+		if vc.RawField != nil {
+			field = vc.RawFieldName
+		}
+		g.Pf("%s(ctx, %s, %s, %s)", g.QualifiedGoIdent(vtPackage.Ident("ObserveContext")),
+			strconv.Quote(string(vc.Msg.Desc.FullName())), strconv.Quote(field), strconv.Quote(parser.KeyString[rule.Key]))
+	}
+	warn := rule.IsWarn(g.config.GetSeverity())
+	if warn {
+		g.Pf("%s(&%s{", g.QualifiedGoIdent(vtPackage.Ident("Report")), g.QualifiedGoIdent(vtPackage.Ident("Violation")))
+	} else {
+		g.Pf("return &%s{", g.QualifiedGoIdent(vtPackage.Ident("Violation")))
+	}
+	g.Pf("ID: %s,", strconv.Quote(messageID(vc, rule)))
+	if vc.RawField != nil {
+		g.Pf("Field: %s,", strconv.Quote(vc.RawFieldName))
+	}
+	g.Pf("Name: %s,", strconv.Quote(name))
+	if value != "" {
+		g.Pf("Value: %s,", value)
+	}
+	if c := rule.Constraint(); c != "" {
+		g.Pf("Constraint: %s,", strconv.Quote(c))
+	}
+	if rule.Message != "" {
+		g.Pf("Message: %s,", strconv.Quote(rule.Message))
+	}
+	if warn {
+		g.P("})")
+	} else {
+		g.P("}")
+	}
+}
+
+// messageID returns the stable message id of a rule, "vt.<type>.<rule>", e.g.
+// "vt.string.min_size", the rules of elements, keys and values use their types.
+func messageID(vc *ValidateContext, rule *parser.Rule) string {
+	return "vt." + validationTypeName(vc) + "." + parser.KeyString[rule.Key]
+}
+
+func validationTypeName(vc *ValidateContext) string {
+	switch vc.ValidationType {
+	case parser.NumericValidation:
+		switch vc.RawField.Desc.Kind() {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			return "float"
+		case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+			return "uint"
+		default:
+			return "int"
+		}
+	case parser.BinaryValidation:
+		if vc.RawField.Desc.Kind() == protoreflect.BytesKind {
+			return "bytes"
+		}
+		return "string"
+	case parser.BoolValidation:
+		return "bool"
+	case parser.EnumValidation:
+		return "enum"
+	case parser.ListValidation:
+		return "repeated"
+	case parser.MapValidation:
+		return "map"
+	default:
+		return "message"
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"strconv"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// generateNormalize generates the Normalize method of a message, which fills the
+// defaults and transforms the strings by the options of the fields, and normalizes
+// the nested messages in the fields, lists and maps.
+func (g *Generator) generateNormalize(st *protogen.Message) error {
+	normalize := g.QualifiedGoIdent(vtPackage.Ident("Normalize"))
+	g.P("// Normalize fills the defaults and transforms the strings of the message and its")
+	g.P("// nested messages by the default, trim, lower and upper options, it's meant to be")
+	g.P("// called before Validate.")
+	g.Pf("func (m *%s) Normalize() {", st.GoIdent.GoName)
+	g.P("if m == nil {")
+	g.P("return")
+	g.P("}")
+	for _, f := range st.Fields {
+		n, err := parser.ParseNormalization(f)
+		if err != nil {
+			return err
+		}
+		isMessage := f.Message != nil && !isWellKnownMessage(f)
+		if f.Desc.IsMap() {
+			isMessage = f.Message.Fields[1].Message != nil && !isWellKnownMessage(f)
+		}
+		if n == nil && !isMessage {
+			continue
+		}
+		ref := "m." + f.GoName
+		oneof := f.Oneof != nil && !f.Oneof.Desc.IsSynthetic()
+		if oneof {
+			g.Pf("if x, ok := m.%s.(*%s); ok {", f.Oneof.GoName, g.QualifiedGoIdent(f.GoIdent))
+			ref = "x." + f.GoName
+		}
+		switch {
+		case f.Desc.IsList():
+			if isMessage {
+				g.Pf("for _, v := range %s {", ref)
+				g.Pf("%s(v)", normalize)
+				g.P("}")
+			} else if n.Elem != nil {
+				g.Pf("for i, v := range %s {", ref)
+				g.Pf("%s[i] = %s", ref, g.transform(n.Elem, "v"))
+				g.P("}")
+			}
+		case f.Desc.IsMap():
+			if isMessage {
+				g.Pf("for _, v := range %s {", ref)
+				g.Pf("%s(v)", normalize)
+				g.P("}")
+			} else if n.Value != nil {
+				g.Pf("for k, v := range %s {", ref)
+				g.Pf("%s[k] = %s", ref, g.transform(n.Value, "v"))
+				g.P("}")
+			}
+		case isMessage:
+			g.Pf("%s(%s)", normalize, ref)
+		default:
+			// the optional scalars are pointers except the bytes
+			pointer := f.Desc.HasPresence() && !oneof && f.Desc.Kind() != protoreflect.BytesKind
+			if n.Default != nil {
+				g.Pf("if %s == nil {", ref)
+				if pointer {
+					g.Pf("v := %s", g.defaultValue(f, n.Default))
+					g.Pf("%s = &v", ref)
+				} else {
+					g.Pf("%s = %s", ref, g.defaultValue(f, n.Default))
+				}
+				g.P("}")
+			}
+			if !n.Trim && !n.Lower && !n.Upper {
+				break
+			}
+			if pointer {
+				g.Pf("if %s != nil {", ref)
+				g.Pf("*%s = %s", ref, g.transform(n, "*"+ref))
+				g.P("}")
+			} else {
+				g.Pf("%s = %s", ref, g.transform(n, ref))
+			}
+		}
+		if oneof {
+			g.P("}")
+		}
+	}
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// transform returns the expression of the string transformed by trim, lower and upper.
+func (g *Generator) transform(n *parser.Normalization, value string) string {
+	if n.Trim {
+		value = g.QualifiedGoIdent(stringsPackage.Ident("TrimSpace")) + "(" + value + ")"
+	}
+	if n.Lower {
+		value = g.QualifiedGoIdent(stringsPackage.Ident("ToLower")) + "(" + value + ")"
+	}
+	if n.Upper {
+		value = g.QualifiedGoIdent(stringsPackage.Ident("ToUpper")) + "(" + value + ")"
+	}
+	return value
+}
+
+// defaultValue returns the typed literal of the default of a field.
+func (g *Generator) defaultValue(f *protogen.Field, v *parser.ValidationValue) string {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(v.TypedValue.Bool)
+	case protoreflect.StringKind:
+		return strconv.Quote(v.TypedValue.Binary)
+	case protoreflect.BytesKind:
+		return "[]byte(" + strconv.Quote(v.TypedValue.Binary) + ")"
+	case protoreflect.EnumKind:
+		for _, ev := range f.Enum.Values {
+			if int64(ev.Desc.Number()) == v.TypedValue.Int {
+				return g.QualifiedGoIdent(ev.GoIdent)
+			}
+		}
+		return g.QualifiedGoIdent(f.Enum.GoIdent) + "(" + strconv.FormatInt(v.TypedValue.Int, 10) + ")"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return "int32(" + strconv.FormatInt(v.TypedValue.Int, 10) + ")"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return "int64(" + strconv.FormatInt(v.TypedValue.Int, 10) + ")"
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "uint32(" + strconv.FormatUint(uint64(v.TypedValue.Int), 10) + ")"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return "uint64(" + strconv.FormatUint(uint64(v.TypedValue.Int), 10) + ")"
+	case protoreflect.FloatKind:
+		return "float32(" + strconv.FormatFloat(v.TypedValue.Double, 'g', -1, 32) + ")"
+	default:
+		return "float64(" + strconv.FormatFloat(v.TypedValue.Double, 'g', -1, 64) + ")"
+	}
+}
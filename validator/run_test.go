@@ -0,0 +1,139 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/internal/plugintest"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// TestGenerateAndRun builds the code generated for testdata/run.proto with the one of
+// protoc-gen-go, and runs the tests in testdata/run against it.
+func TestGenerateAndRun(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go build in short mode")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not found")
+	}
+	root, err := filepath.Abs("..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+
+	gen := plugintest.New(t, "../testdata/run.pb", "", "run.proto")
+	for _, f := range gen.Files {
+		if !f.Generate {
+			continue
+		}
+		g, err := NewGenerator(gen, f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := g.Generate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFiles(t, dir, gen.Response())
+
+	// the code of protoc-gen-go is generated by running it as protoc does
+	protocGenGo := filepath.Join(dir, "bin", "protoc-gen-go")
+	run(t, root, nil, goTool, "build", "-o", protocGenGo, "google.golang.org/protobuf/cmd/protoc-gen-go")
+	gen.Request.Parameter = proto.String("Mapi.proto=github.com/cloudwego/protoc-gen-validator/parser/api")
+	req, err := proto.Marshal(gen.Request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := run(t, dir, req, protocGenGo)
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out, resp); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, dir, resp)
+	if err := os.RemoveAll(filepath.Join(dir, "bin")); err != nil {
+		t.Fatal(err)
+	}
+
+	hand, err := filepath.Glob("testdata/run/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range hand {
+		b, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, filepath.Join(dir, filepath.Base(name)), b)
+	}
+	writeFile(t, filepath.Join(dir, "go.mod"), []byte(`module example.com/run
+
+go 1.16
+
+require (
+	github.com/cloudwego/protoc-gen-validator v0.0.0
+	google.golang.org/protobuf v1.28.1
+)
+
+replace github.com/cloudwego/protoc-gen-validator => `+root+"\n"))
+	sum, err := ioutil.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, filepath.Join(dir, "go.sum"), sum)
+
+	run(t, dir, nil, goTool, "test", "-mod=mod", "./...")
+}
+
+func writeFiles(t *testing.T, dir string, resp *pluginpb.CodeGeneratorResponse) {
+	t.Helper()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+	for _, f := range resp.File {
+		writeFile(t, filepath.Join(dir, filepath.Base(f.GetName())), []byte(f.GetContent()))
+	}
+}
+
+func writeFile(t *testing.T, name string, b []byte) {
+	t.Helper()
+	if err := ioutil.WriteFile(name, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// run runs the command in dir with stdin, and returns its stdout.
+func run(t *testing.T, dir string, stdin []byte, name string, args ...string) []byte {
+	t.Helper()
+	cmd := exec.Command(name, args...)
+	cmd.Dir = dir
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("%s %v failed: %v\n%s%s", name, args, err, stdout.String(), stderr.String())
+	}
+	return stdout.Bytes()
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+)
+
+// generateServiceDispatchers generates a dispatcher for each service, which
+// validates the messages of the methods by the method_vt options.
+func (g *Generator) generateServiceDispatchers() error {
+	for _, s := range g.PbFile.Services {
+		var skipped, responses []string
+		for _, m := range s.Methods {
+			mv, err := parser.ParseMethod(m)
+			if err != nil {
+				return err
+			}
+			// the methods are called by name in kitex and hz, and by full method in grpc
+			names := strconv.Quote(string(m.Desc.Name())) + ", " + strconv.Quote(fmt.Sprintf("/%s/%s", s.Desc.FullName(), m.Desc.Name()))
+			if !mv.Request {
+				skipped = append(skipped, names)
+			}
+			if mv.Response {
+				responses = append(responses, names)
+			}
+		}
+		d := s.GoName + "ValidateDispatcher"
+		ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
+		background := g.QualifiedGoIdent(contextPackage.Ident("Background"))
+		validate := g.QualifiedGoIdent(vtPackage.Ident("ValidateWithContext"))
+		g.Pf("// %s validates the messages of the service %s by the method_vt options", d, s.Desc.FullName())
+		g.P("// of the methods, a method is given by its name or the full method of grpc.")
+		g.Pf("type %s struct{}", d)
+		g.P()
+		g.P("// ValidateRequest calls Validate on the request of the method unless it's disabled.")
+		g.Pf("func (d %s) ValidateRequest(method string, req interface{}) error {", d)
+		g.Pf("return d.ValidateRequestWithContext(%s(), method, req)", background)
+		g.P("}")
+		g.P()
+		g.P("// ValidateRequestWithContext is ValidateRequest calling ValidateWithContext with ctx.")
+		g.Pf("func (%s) ValidateRequestWithContext(ctx %s, method string, req interface{}) error {", d, ctx)
+		if len(skipped) > 0 {
+			g.P("switch method {")
+			g.Pf("case %s:", strings.Join(skipped, ", "))
+			g.P("return nil")
+			g.P("}")
+		}
+		g.Pf("return %s(ctx, req)", validate)
+		g.P("}")
+		g.P()
+		g.P("// ValidateResponse calls Validate on the response of the method if it's enabled.")
+		g.Pf("func (d %s) ValidateResponse(method string, resp interface{}) error {", d)
+		g.Pf("return d.ValidateResponseWithContext(%s(), method, resp)", background)
+		g.P("}")
+		g.P()
+		g.P("// ValidateResponseWithContext is ValidateResponse calling ValidateWithContext with ctx.")
+		g.Pf("func (%s) ValidateResponseWithContext(ctx %s, method string, resp interface{}) error {", d, ctx)
+		if len(responses) == 0 {
+			g.P("return nil")
+			g.P("}")
+			g.P()
+			continue
+		}
+		g.P("switch method {")
+		g.Pf("case %s:", strings.Join(responses, ", "))
+		g.P("default:")
+		g.P("return nil")
+		g.P("}")
+		g.P("// a handler may return a nil response")
+		g.P("if v, ok := resp.(interface{ Validate() error }); ok && !reflect.ValueOf(v).IsNil() {")
+		g.Pf("return %s(ctx, v)", validate)
+		g.P("}")
+		g.P("return nil")
+		g.P("}")
+		g.P()
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package run
+
+import "errors"
+
+// ValidateExtra refers to the fields of m, which is not nil when it is called.
+func (m *Resp) ValidateExtra() error {
+	if m.Name == "reserved" {
+		return errors.New("name is reserved")
+	}
+	return nil
+}
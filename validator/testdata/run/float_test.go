@@ -0,0 +1,55 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package run
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/vt"
+)
+
+func TestFloatRules(t *testing.T) {
+	valid := func() *Reading { return &Reading{Value: 0.5, Ratio: 0.3} }
+	tests := []struct {
+		name  string
+		patch func(m *Reading)
+		id    string
+	}{
+		{"valid", func(m *Reading) {}, ""},
+		{"epsilon", func(m *Reading) { m.Ratio = 0.3009 }, ""},
+		{"allowed nan", func(m *Reading) { m.Score = math.NaN() }, ""},
+		{"nan", func(m *Reading) { m.Value = math.NaN() }, "vt.float.ge"},
+		{"nan const", func(m *Reading) { m.Ratio = math.NaN() }, "vt.float.const"},
+		{"out of epsilon", func(m *Reading) { m.Ratio = 0.302 }, "vt.float.const"},
+		{"infinity", func(m *Reading) { m.Weight = float32(math.Inf(-1)) }, "vt.float.finite"},
+		{"multiple of", func(m *Reading) { m.Step = -7 }, "vt.int.multiple_of"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := valid()
+			tt.patch(m)
+			err := m.Validate()
+			var v *vt.Violation
+			switch {
+			case tt.id == "" && err != nil:
+				t.Errorf("Validate() = %v, want nil", err)
+			case tt.id != "" && (!errors.As(err, &v) || v.ID != tt.id):
+				t.Errorf("Validate() = %v, want a violation of %s", err, tt.id)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package run
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestNormalize(t *testing.T) {
+	m := &Profile{
+		Name:   "  Alice ",
+		Tags:   []string{" a", "b "},
+		Labels: map[string]string{"team": " infra "},
+		Parent: &Profile{Name: " BOB", Page: proto.Int32(3)},
+	}
+	if err := m.Validate(); err == nil {
+		t.Error("Validate() before Normalize() should fail for the unset page")
+	}
+	m.Normalize()
+	want := &Profile{
+		Page:     proto.Int32(1),
+		Kind:     Kind_KIND_USER.Enum(),
+		Nickname: proto.String("anonymous"),
+		Name:     "alice",
+		Tags:     []string{"A", "B"},
+		Labels:   map[string]string{"team": "infra"},
+		Parent: &Profile{
+			Page:     proto.Int32(3),
+			Kind:     Kind_KIND_USER.Enum(),
+			Nickname: proto.String("anonymous"),
+			Name:     "bob",
+		},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("Normalize() = %v, want %v", m, want)
+	}
+	if err := m.Validate(); err != nil {
+		t.Errorf("Validate() after Normalize() = %v, want nil", err)
+	}
+	// the set fields are kept
+	m.Page = proto.Int32(2)
+	m.Normalize()
+	if m.GetPage() != 2 || !reflect.DeepEqual(m.GetTags(), []string{"A", "B"}) {
+		t.Errorf("Normalize() again = %v", m)
+	}
+	(*Profile)(nil).Normalize()
+}
@@ -0,0 +1,45 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package run
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateExtra(t *testing.T) {
+	if err := (&Resp{Name: "reserved"}).Validate(); err == nil {
+		t.Error("Validate() should call ValidateExtra")
+	}
+	if err := (&Resp{Name: "name"}).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	if err := (*Resp)(nil).Validate(); err != nil {
+		t.Errorf("Validate() of a nil message = %v, want nil", err)
+	}
+}
+
+func TestValidateWithContext(t *testing.T) {
+	ctx := context.Background()
+	if err := (&Resp{Name: "reserved"}).ValidateWithContext(ctx); err == nil {
+		t.Error("ValidateWithContext() should call ValidateExtra")
+	}
+	if err := (&Resp{Name: "a name longer than 16"}).ValidateFieldsWithContext(ctx, "name"); err == nil {
+		t.Error("ValidateFieldsWithContext() should check the max_size of name")
+	}
+	if err := (*Resp)(nil).ValidateWithContext(ctx); err != nil {
+		t.Errorf("ValidateWithContext() of a nil message = %v, want nil", err)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package run
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cloudwego/protoc-gen-validator/vt"
+)
+
+func TestTypeRules(t *testing.T) {
+	usd := func() *Money { return &Money{Currency: "USD"} }
+	tests := []struct {
+		name  string
+		order *Order
+		field string
+	}{
+		{"valid", &Order{Total: usd(), Currency: Currency_CURRENCY_USD}, ""},
+		{"all set", &Order{
+			Total:      usd(),
+			Discount:   usd(),
+			Items:      []*Money{usd()},
+			Prices:     map[string]*Money{"a": usd(), "b": nil},
+			Currency:   Currency_CURRENCY_USD,
+			Currencies: []Currency{Currency_CURRENCY_USD},
+		}, ""},
+		{"unset total", &Order{Currency: Currency_CURRENCY_USD}, "total"},
+		{"invalid total", &Order{Total: &Money{}, Currency: Currency_CURRENCY_USD}, "total.currency"},
+		{"invalid discount", &Order{Total: usd(), Discount: &Money{Currency: "x"}, Currency: Currency_CURRENCY_USD}, "discount.currency"},
+		{"nil item", &Order{Total: usd(), Items: []*Money{nil}, Currency: Currency_CURRENCY_USD}, "items"},
+		{"invalid price", &Order{Total: usd(), Prices: map[string]*Money{"a": {}}, Currency: Currency_CURRENCY_USD}, `prices["a"].currency`},
+		{"unspecified currency", &Order{Total: usd()}, "currency"},
+		{"unspecified currencies", &Order{Total: usd(), Currency: Currency_CURRENCY_USD, Currencies: []Currency{Currency_CURRENCY_UNSPECIFIED}}, "currencies"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.order.Validate()
+			if tt.field == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("Validate() = nil, want an error of %s", tt.field)
+			}
+			var v *vt.Violation
+			if !errors.As(err, &v) || v.Field != tt.field {
+				t.Errorf("Validate() = %v, want an error of %s", err, tt.field)
+			}
+		})
+	}
+}
@@ -85,3 +85,16 @@ func IsBaseType(f *protogen.Field) bool {
 func ParamsToArgs(params string) []string {
 	return strings.Split(params, ",")
 }
+
+// canBeNil reports whether the Go field of fd can be compared with nil.
+func canBeNil(fd protoreflect.FieldDescriptor) bool {
+	switch {
+	case fd.IsList(), fd.IsMap(), fd.HasPresence():
+		return true
+	}
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+		return true
+	}
+	return false
+}
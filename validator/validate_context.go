@@ -30,6 +30,7 @@ type ValidateContext struct {
 	RawFieldName string // raw field name in idl
 	GetNameFunc  string // Get***() func for getting the generated value
 	IsOptional   bool
+	ElemKey      string // the index of the list element or the key of the map value
 	ids          map[string]int
 }
 
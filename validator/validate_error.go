@@ -0,0 +1,51 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const errorsPackage = protogen.GoImportPath("errors")
+
+// generateValidateError generates ValidateError, which takes the path of the invalid
+// field from the *vt.Violation returned by Validate for the framework adapters.
+func (g *Generator) generateValidateError() {
+	g.P("// ValidateError is an error of Validate with the path of the invalid field, the")
+	g.P("// path is made of the field names in idl joined by dots, and is empty for the")
+	g.P("// message level rules of the request.")
+	g.P("type ValidateError struct {")
+	g.P("Field string")
+	g.P("Err   error")
+	g.P("}")
+	g.P()
+	g.P("// NewValidateError takes the field path from an error returned by Validate.")
+	g.P("func NewValidateError(err error) *ValidateError {")
+	g.Pf("var v *%s", g.QualifiedGoIdent(vtPackage.Ident("Violation")))
+	g.Pf("if %s(err, &v) {", g.QualifiedGoIdent(errorsPackage.Ident("As")))
+	g.P("return &ValidateError{Field: v.Field, Err: err}")
+	g.P("}")
+	g.P("return &ValidateError{Err: err}")
+	g.P("}")
+	g.P()
+	g.P("func (e *ValidateError) Error() string {")
+	g.P("return e.Err.Error()")
+	g.P("}")
+	g.P()
+	g.P("func (e *ValidateError) Unwrap() error {")
+	g.P("return e.Err")
+	g.P("}")
+	g.P()
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+// English is the default catalog of the message ids, the placeholders {field},
+// {value} and {constraint} are replaced with the name of the field, the current
+// value and the constraint of the rule.
+var English = map[string]string{
+	"vt.int.const":       "field {field} not match const value, current value: {value}",
+	"vt.int.lt":          "field {field} lt rule failed, current value: {value}",
+	"vt.int.le":          "field {field} le rule failed, current value: {value}",
+	"vt.int.gt":          "field {field} gt rule failed, current value: {value}",
+	"vt.int.ge":          "field {field} ge rule failed, current value: {value}",
+	"vt.int.in":          "field {field} in rule failed, current value: {value}",
+	"vt.int.not_in":      "field {field} not_in rule failed, current value: {value}",
+	"vt.int.not_nil":     "field {field} not_nil rule failed",
+	"vt.int.multiple_of": "field {field} multiple_of rule failed, current value: {value}",
+
+	"vt.uint.const":       "field {field} not match const value, current value: {value}",
+	"vt.uint.lt":          "field {field} lt rule failed, current value: {value}",
+	"vt.uint.le":          "field {field} le rule failed, current value: {value}",
+	"vt.uint.gt":          "field {field} gt rule failed, current value: {value}",
+	"vt.uint.ge":          "field {field} ge rule failed, current value: {value}",
+	"vt.uint.in":          "field {field} in rule failed, current value: {value}",
+	"vt.uint.not_in":      "field {field} not_in rule failed, current value: {value}",
+	"vt.uint.not_nil":     "field {field} not_nil rule failed",
+	"vt.uint.multiple_of": "field {field} multiple_of rule failed, current value: {value}",
+
+	"vt.float.const":   "field {field} not match const value, current value: {value}",
+	"vt.float.lt":      "field {field} lt rule failed, current value: {value}",
+	"vt.float.le":      "field {field} le rule failed, current value: {value}",
+	"vt.float.gt":      "field {field} gt rule failed, current value: {value}",
+	"vt.float.ge":      "field {field} ge rule failed, current value: {value}",
+	"vt.float.in":      "field {field} in rule failed, current value: {value}",
+	"vt.float.not_in":  "field {field} not_in rule failed, current value: {value}",
+	"vt.float.not_nil": "field {field} not_nil rule failed",
+	"vt.float.finite":  "field {field} finite rule failed, current value: {value}",
+
+	"vt.string.min_size":     "field {field} min_len rule failed, current value: {value}",
+	"vt.string.max_size":     "field {field} max_len rule failed, current value: {value}",
+	"vt.string.const":        "field {field} not match const value, current value: {value}",
+	"vt.string.prefix":       "field {field} prefix rule failed, current value: {value}",
+	"vt.string.suffix":       "field {field} suffix rule failed, current value: {value}",
+	"vt.string.contains":     "field {field} contains rule failed, current value: {value}",
+	"vt.string.not_contains": "field {field} not_contains rule failed, current value: {value}",
+	"vt.string.pattern":      "field {field} pattern rule failed, current value: {value}",
+	"vt.string.in":           "field {field} in rule failed, current value: {value}",
+	"vt.string.not_in":       "field {field} not_in rule failed, current value: {value}",
+	"vt.string.not_nil":      "field {field} not_nil rule failed",
+
+	"vt.bytes.min_size":     "field {field} min_len rule failed, current value: {value}",
+	"vt.bytes.max_size":     "field {field} max_len rule failed, current value: {value}",
+	"vt.bytes.const":        "field {field} not match const value, current value: {value}",
+	"vt.bytes.prefix":       "field {field} prefix rule failed, current value: {value}",
+	"vt.bytes.suffix":       "field {field} suffix rule failed, current value: {value}",
+	"vt.bytes.contains":     "field {field} contains rule failed, current value: {value}",
+	"vt.bytes.not_contains": "field {field} not_contains rule failed, current value: {value}",
+	"vt.bytes.pattern":      "field {field} pattern rule failed, current value: {value}",
+	"vt.bytes.in":           "field {field} in rule failed, current value: {value}",
+	"vt.bytes.not_in":       "field {field} not_in rule failed, current value: {value}",
+	"vt.bytes.not_nil":      "field {field} not_nil rule failed",
+
+	"vt.bool.const":   "field {field} const rule failed, current value: {value}",
+	"vt.bool.not_nil": "field {field} not_nil rule failed",
+
+	"vt.enum.const":        "field {field} const rule failed, current value: {value}",
+	"vt.enum.defined_only": "field {field} defined_only rule failed, current value: {value}",
+	"vt.enum.not_nil":      "field {field} not_nil rule failed",
+	"vt.enum.in":           "field {field} in rule failed, current value: {value}",
+	"vt.enum.not_in":       "field {field} not_in rule failed, current value: {value}",
+
+	"vt.repeated.min_size": "field {field} MinLen rule failed, current value: {value}",
+	"vt.repeated.max_size": "field {field} MaxLen rule failed, current value: {value}",
+	"vt.repeated.not_nil":  "field {field} not_nil rule failed",
+
+	"vt.map.min_size":  "field {field} min_size rule failed, current value: {value}",
+	"vt.map.max_size":  "field {field} max_size rule failed, current value: {value}",
+	"vt.map.no_sparse": "field {field} no_sparse rule failed, current value: {value}",
+	"vt.map.not_nil":   "field {field} not_nil rule failed",
+
+	"vt.message.not_nil":        "field {field} not_nil rule failed",
+	"vt.message.assert":         "struct assertion failed",
+	"vt.message.oneof_required": "oneof {constraint} required rule failed",
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNow(t *testing.T) {
+	fixed := time.Unix(1700000000, 0)
+	ctx := WithClock(context.Background(), func() time.Time { return fixed })
+	if got := Now(ctx); !got.Equal(fixed) {
+		t.Errorf("Now() = %v, want %v", got, fixed)
+	}
+	before := time.Now()
+	if got := Now(context.Background()); got.Before(before) {
+		t.Errorf("Now() without a clock = %v, want time.Now", got)
+	}
+	if got := Now(WithClock(context.Background(), nil)); got.Before(before) {
+		t.Errorf("Now() with a nil clock = %v, want time.Now", got)
+	}
+}
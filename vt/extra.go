@@ -0,0 +1,61 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"reflect"
+)
+
+// ExtraValidator is implemented by the messages with the hand-written checks, which
+// are called by Validate after the rules pass.
+type ExtraValidator interface {
+	ValidateExtra() error
+}
+
+// ContextExtraValidator is the context-aware variant of ExtraValidator, which is
+// preferred if a message implements both.
+type ContextExtraValidator interface {
+	ValidateExtraWithContext(ctx context.Context) error
+}
+
+// ValidateExtra calls the hand-written checks of m if it has any, which are not
+// called for a nil message, like the rules.
+func ValidateExtra(ctx context.Context, m interface{}) error {
+	if v := reflect.ValueOf(m); v.Kind() == reflect.Ptr && v.IsNil() {
+		return nil
+	}
+	switch v := m.(type) {
+	case ContextExtraValidator:
+		return v.ValidateExtraWithContext(ctx)
+	case ExtraValidator:
+		return v.ValidateExtra()
+	}
+	return nil
+}
+
+// ValidateWithContext calls ValidateWithContext of m, or Validate if m is generated
+// without it, nil is returned if m has neither.
+func ValidateWithContext(ctx context.Context, m interface{}) error {
+	switch v := m.(type) {
+	case interface {
+		ValidateWithContext(context.Context) error
+	}:
+		return v.ValidateWithContext(ctx)
+	case interface{ Validate() error }:
+		return v.Validate()
+	}
+	return nil
+}
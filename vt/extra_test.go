@@ -0,0 +1,112 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type extraMessage struct {
+	name string
+}
+
+func (m *extraMessage) ValidateExtra() error {
+	if m.name == "reserved" {
+		return errors.New("name is reserved")
+	}
+	return nil
+}
+
+type contextExtraMessage struct {
+	extraMessage
+}
+
+type ctxKey struct{}
+
+func (m *contextExtraMessage) ValidateExtraWithContext(ctx context.Context) error {
+	if ctx.Value(ctxKey{}) != nil {
+		return errors.New("with context")
+	}
+	return m.ValidateExtra()
+}
+
+func TestValidateExtra(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+	tests := []struct {
+		name string
+		m    interface{}
+		want string
+	}{
+		{"extra", &extraMessage{name: "reserved"}, "name is reserved"},
+		{"passed", &extraMessage{name: "name"}, ""},
+		{"context preferred", &contextExtraMessage{extraMessage{name: "reserved"}}, "with context"},
+		{"nil", (*extraMessage)(nil), ""},
+		{"nil with context", (*contextExtraMessage)(nil), ""},
+		{"no checks", struct{}{}, ""},
+		{"untyped nil", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateExtra(ctx, tt.m)
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("ValidateExtra() = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+type contextMessage struct{}
+
+func (contextMessage) Validate() error {
+	return errors.New("Validate")
+}
+
+func (contextMessage) ValidateWithContext(ctx context.Context) error {
+	if ctx.Value(ctxKey{}) == nil {
+		return errors.New("no context")
+	}
+	return errors.New("ValidateWithContext")
+}
+
+func TestValidateWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, true)
+	tests := []struct {
+		name string
+		m    interface{}
+		want string
+	}{
+		{"context preferred", contextMessage{}, "ValidateWithContext"},
+		{"validate", plainMessage{}, "Validate"},
+		{"neither", struct{}{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateWithContext(ctx, tt.m)
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("ValidateWithContext() = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// ForceAsserts is a path for ValidateFields checking the message level rules, such
+// as assert, even if they refer to the fields not in the paths.
+const ForceAsserts = "@asserts"
+
+// FieldMask is the set of the field paths validated by ValidateFields, a nil
+// *FieldMask has all the fields.
+type FieldMask struct {
+	// fields maps the names of the fields to the masks of their nested messages, a
+	// nil mask has the whole field
+	fields map[string]*FieldMask
+	force  bool
+}
+
+// NewFieldMask returns the mask of the paths like "inner.code", which is nil if the
+// paths have "*" for all the fields.
+func NewFieldMask(paths ...string) *FieldMask {
+	m := &FieldMask{fields: map[string]*FieldMask{}}
+	for _, p := range paths {
+		switch p {
+		case "*":
+			return nil
+		case ForceAsserts:
+			m.force = true
+			continue
+		}
+		m.add(strings.Split(p, "."))
+	}
+	m.setForce(m.force)
+	return m
+}
+
+func (m *FieldMask) add(names []string) {
+	sub, ok := m.fields[names[0]]
+	if ok && sub == nil {
+		// the whole field is in the mask
+		return
+	}
+	if len(names) == 1 {
+		m.fields[names[0]] = nil
+		return
+	}
+	if !ok {
+		sub = &FieldMask{fields: map[string]*FieldMask{}}
+		m.fields[names[0]] = sub
+	}
+	sub.add(names[1:])
+}
+
+func (m *FieldMask) setForce(force bool) {
+	m.force = force
+	for _, sub := range m.fields {
+		if sub != nil {
+			sub.setForce(force)
+		}
+	}
+}
+
+// Has reports whether the field or some of its nested fields are in the mask.
+func (m *FieldMask) Has(field string) bool {
+	if m == nil {
+		return true
+	}
+	_, ok := m.fields[field]
+	return ok
+}
+
+// Sub returns the mask of the nested message of the field.
+func (m *FieldMask) Sub(field string) *FieldMask {
+	if m == nil {
+		return nil
+	}
+	return m.fields[field]
+}
+
+// Forced reports whether the message level rules are checked regardless of the
+// fields they refer to.
+func (m *FieldMask) Forced() bool {
+	return m == nil || m.force
+}
+
+// Paths returns the paths of the mask, which are nil for all the fields.
+func (m *FieldMask) Paths() []string {
+	if m == nil {
+		return nil
+	}
+	var paths []string
+	for name, sub := range m.fields {
+		if sub == nil {
+			paths = append(paths, name)
+			continue
+		}
+		for _, p := range sub.Paths() {
+			if p != ForceAsserts {
+				paths = append(paths, name+"."+p)
+			}
+		}
+	}
+	sort.Strings(paths)
+	if m.force {
+		paths = append(paths, ForceAsserts)
+	}
+	return paths
+}
+
+// ValidateMasked validates the nested message v with the mask, by ValidateFields
+// if the mask is not nil and v has it, or by Validate otherwise.
+func ValidateMasked(v interface{ Validate() error }, mask *FieldMask) error {
+	return ValidateMaskedContext(context.Background(), v, mask)
+}
+
+// ValidateMaskedContext is ValidateMasked passing ctx to the nested message, which
+// is dropped if v is generated without ValidateWithContext.
+func ValidateMaskedContext(ctx context.Context, v interface{ Validate() error }, mask *FieldMask) error {
+	if mask != nil {
+		if vf, ok := v.(interface {
+			ValidateFieldsWithContext(context.Context, ...string) error
+		}); ok {
+			return vf.ValidateFieldsWithContext(ctx, mask.Paths()...)
+		}
+		if vf, ok := v.(interface{ ValidateFields(...string) error }); ok {
+			return vf.ValidateFields(mask.Paths()...)
+		}
+	}
+	if vc, ok := v.(interface {
+		ValidateWithContext(context.Context) error
+	}); ok {
+		return vc.ValidateWithContext(ctx)
+	}
+	return v.Validate()
+}
@@ -0,0 +1,169 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFieldMask(t *testing.T) {
+	tests := []struct {
+		name   string
+		paths  []string
+		has    map[string]bool
+		forced bool
+		want   []string
+	}{
+		{
+			name:   "all",
+			paths:  []string{"name", "*"},
+			has:    map[string]bool{"name": true, "page": true},
+			forced: true,
+		},
+		{
+			name:  "fields",
+			paths: []string{"page", "name"},
+			has:   map[string]bool{"name": true, "page": true, "code": false},
+			want:  []string{"name", "page"},
+		},
+		{
+			name:  "nested",
+			paths: []string{"main.name", "main.count", "items"},
+			has:   map[string]bool{"main": true, "items": true, "name": false},
+			want:  []string{"items", "main.count", "main.name"},
+		},
+		{
+			name:  "whole field over nested",
+			paths: []string{"main.name", "main", "main.count"},
+			has:   map[string]bool{"main": true},
+			want:  []string{"main"},
+		},
+		{
+			name:   "forced",
+			paths:  []string{ForceAsserts, "main.name"},
+			has:    map[string]bool{"main": true, "page": false},
+			forced: true,
+			want:   []string{"main.name", ForceAsserts},
+		},
+		{
+			name: "none",
+			has:  map[string]bool{"name": false},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewFieldMask(tt.paths...)
+			for field, want := range tt.has {
+				if got := m.Has(field); got != want {
+					t.Errorf("Has(%q) = %v, want %v", field, got, want)
+				}
+			}
+			if got := m.Forced(); got != tt.forced {
+				t.Errorf("Forced() = %v, want %v", got, tt.forced)
+			}
+			if got := m.Paths(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Paths() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldMaskSub(t *testing.T) {
+	m := NewFieldMask(ForceAsserts, "main.name", "items", "a.b.c")
+	if sub := m.Sub("main"); !reflect.DeepEqual(sub.Paths(), []string{"name", ForceAsserts}) {
+		t.Errorf("Sub(main).Paths() = %q, the nested masks should keep the force", sub.Paths())
+	}
+	if sub := m.Sub("items"); sub != nil {
+		t.Errorf("Sub(items) = %v, want nil for the whole field", sub.Paths())
+	}
+	if sub := m.Sub("a").Sub("b"); !sub.Has("c") || sub.Has("d") {
+		t.Errorf("Sub(a).Sub(b).Paths() = %q, want c", sub.Paths())
+	}
+}
+
+type maskedMessage struct {
+	paths []string
+}
+
+func (m *maskedMessage) Validate() error {
+	return errors.New("Validate")
+}
+
+func (m *maskedMessage) ValidateFields(paths ...string) error {
+	m.paths = paths
+	return errors.New("ValidateFields")
+}
+
+type plainMessage struct{}
+
+func (plainMessage) Validate() error {
+	return errors.New("Validate")
+}
+
+func TestValidateMasked(t *testing.T) {
+	m := &maskedMessage{}
+	if err := ValidateMasked(m, nil); err == nil || err.Error() != "Validate" {
+		t.Errorf("ValidateMasked() with a nil mask = %v, want Validate", err)
+	}
+	if err := ValidateMasked(m, NewFieldMask("name")); err == nil || err.Error() != "ValidateFields" {
+		t.Errorf("ValidateMasked() = %v, want ValidateFields", err)
+	}
+	if !reflect.DeepEqual(m.paths, []string{"name"}) {
+		t.Errorf("ValidateFields() is called with %q, want name", m.paths)
+	}
+	if err := ValidateMasked(plainMessage{}, NewFieldMask("name")); err == nil || err.Error() != "Validate" {
+		t.Errorf("ValidateMasked() of a message without ValidateFields = %v, want Validate", err)
+	}
+}
+
+type contextMaskedMessage struct {
+	maskedMessage
+}
+
+func (m *contextMaskedMessage) ValidateWithContext(ctx context.Context) error {
+	return fmt.Errorf("ValidateWithContext %v", ctx.Value(ctxKey{}))
+}
+
+func (m *contextMaskedMessage) ValidateFieldsWithContext(ctx context.Context, paths ...string) error {
+	m.paths = paths
+	return fmt.Errorf("ValidateFieldsWithContext %v", ctx.Value(ctxKey{}))
+}
+
+func TestValidateMaskedContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxKey{}, "ctx")
+	tests := []struct {
+		name string
+		v    interface{ Validate() error }
+		mask *FieldMask
+		want string
+	}{
+		{"nil mask", &contextMaskedMessage{}, nil, "ValidateWithContext ctx"},
+		{"mask", &contextMaskedMessage{}, NewFieldMask("name"), "ValidateFieldsWithContext ctx"},
+		{"without context", &maskedMessage{}, NewFieldMask("name"), "ValidateFields"},
+		{"plain", plainMessage{}, NewFieldMask("name"), "Validate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateMaskedContext(ctx, tt.v, tt.mask); err == nil || err.Error() != tt.want {
+				t.Errorf("ValidateMaskedContext() = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
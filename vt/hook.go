@@ -0,0 +1,159 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Hook observes the failed rules, the generated code calls the hooks only if it's
+// generated with the hooks parameter.
+type Hook interface {
+	// Observe is called on each failed rule with the full name of the message, the
+	// name of the field in idl (empty for the message level rules) and the rule key.
+	Observe(message, field, rule string)
+}
+
+// ContextHook is a Hook that also reads the context passed to ValidateWithContext,
+// ObserveContext is called instead of Observe if a hook implements it.
+type ContextHook interface {
+	Hook
+	ObserveContext(ctx context.Context, message, field, rule string)
+}
+
+// HookFunc is a Hook of a function.
+type HookFunc func(message, field, rule string)
+
+func (f HookFunc) Observe(message, field, rule string) {
+	f(message, field, rule)
+}
+
+// Labels are the label names of the counters passed to LabelsHook.
+var Labels = []string{"message", "field", "rule"}
+
+// LabelsHook returns a Hook increasing a counter with the values of Labels, which
+// suits the counter vectors of prometheus:
+//
+//	vec := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "validation_failures_total"}, vt.Labels)
+//	vt.SetHooks(vt.LabelsHook(func(lvs ...string) { vec.WithLabelValues(lvs...).Inc() }))
+func LabelsHook(inc func(labelValues ...string)) Hook {
+	return HookFunc(func(message, field, rule string) {
+		inc(message, field, rule)
+	})
+}
+
+var (
+	hooksMu sync.RWMutex
+	hooks   []Hook
+)
+
+// SetHooks sets the hooks observing the failed rules.
+func SetHooks(hs ...Hook) {
+	hooksMu.Lock()
+	hooks = hs
+	hooksMu.Unlock()
+}
+
+// Observe passes a failed rule to the hooks.
+func Observe(message, field, rule string) {
+	ObserveContext(context.Background(), message, field, rule)
+}
+
+// ObserveContext passes a failed rule to the hooks with the context of the validation.
+func ObserveContext(ctx context.Context, message, field, rule string) {
+	hooksMu.RLock()
+	hs := hooks
+	hooksMu.RUnlock()
+	for _, h := range hs {
+		if ch, ok := h.(ContextHook); ok {
+			ch.ObserveContext(ctx, message, field, rule)
+			continue
+		}
+		h.Observe(message, field, rule)
+	}
+}
+
+// CounterKey is a failed rule counted by Counter.
+type CounterKey struct {
+	Message string
+	Field   string
+	Rule    string
+}
+
+// Counter is a Hook counting the failed rules in process.
+type Counter struct {
+	mu     sync.Mutex
+	counts map[CounterKey]uint64
+}
+
+// NewCounter returns an empty Counter.
+func NewCounter() *Counter {
+	return &Counter{counts: map[CounterKey]uint64{}}
+}
+
+func (c *Counter) Observe(message, field, rule string) {
+	c.mu.Lock()
+	c.counts[CounterKey{Message: message, Field: field, Rule: rule}]++
+	c.mu.Unlock()
+}
+
+// Count returns the number of the failures of a rule.
+func (c *Counter) Count(message, field, rule string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.counts[CounterKey{Message: message, Field: field, Rule: rule}]
+}
+
+// CounterEntry is the count of a failed rule.
+type CounterEntry struct {
+	CounterKey
+	Count uint64
+}
+
+// Top returns the n rules failed most, or all of them if n <= 0.
+func (c *Counter) Top(n int) []CounterEntry {
+	c.mu.Lock()
+	ret := make([]CounterEntry, 0, len(c.counts))
+	for k, v := range c.counts {
+		ret = append(ret, CounterEntry{CounterKey: k, Count: v})
+	}
+	c.mu.Unlock()
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		a, b := ret[i].CounterKey, ret[j].CounterKey
+		if a.Message != b.Message {
+			return a.Message < b.Message
+		}
+		if a.Field != b.Field {
+			return a.Field < b.Field
+		}
+		return a.Rule < b.Rule
+	})
+	if n > 0 && len(ret) > n {
+		ret = ret[:n]
+	}
+	return ret
+}
+
+// Reset clears the counts.
+func (c *Counter) Reset() {
+	c.mu.Lock()
+	c.counts = map[CounterKey]uint64{}
+	c.mu.Unlock()
+}
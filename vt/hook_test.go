@@ -0,0 +1,142 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestObserve(t *testing.T) {
+	defer SetHooks()
+	var got, labels []string
+	SetHooks(
+		HookFunc(func(message, field, rule string) {
+			got = append(got, message+"."+field+":"+rule)
+		}),
+		LabelsHook(func(lvs ...string) {
+			labels = append(labels, lvs...)
+		}),
+	)
+	Observe("fixture.Request", "page", "lt")
+	Observe("fixture.Request", "", "assert")
+	if want := []string{"fixture.Request.page:lt", "fixture.Request.:assert"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("observed %q, want %q", got, want)
+	}
+	if want := []string{"fixture.Request", "page", "lt", "fixture.Request", "", "assert"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("labels = %q, want %q", labels, want)
+	}
+	if len(Labels) != 3 {
+		t.Errorf("Labels = %q, want the names of 3 labels", Labels)
+	}
+
+	SetHooks()
+	Observe("fixture.Request", "page", "lt")
+	if len(got) != 2 {
+		t.Errorf("observed %q after the hooks are removed", got)
+	}
+}
+
+func TestCounter(t *testing.T) {
+	c := NewCounter()
+	for _, r := range []CounterKey{
+		{"a.Req", "name", "min_size"},
+		{"a.Req", "page", "lt"},
+		{"a.Req", "name", "min_size"},
+		{"b.Req", "", "assert"},
+		{"a.Req", "page", "gt"},
+		{"a.Req", "page", "lt"},
+		{"a.Req", "name", "min_size"},
+	} {
+		c.Observe(r.Message, r.Field, r.Rule)
+	}
+	if got := c.Count("a.Req", "name", "min_size"); got != 3 {
+		t.Errorf("Count() = %d, want 3", got)
+	}
+	if got := c.Count("a.Req", "name", "max_size"); got != 0 {
+		t.Errorf("Count() of a rule not failed = %d, want 0", got)
+	}
+	tests := []struct {
+		n    int
+		want []CounterEntry
+	}{
+		{
+			n: 2,
+			want: []CounterEntry{
+				{CounterKey{"a.Req", "name", "min_size"}, 3},
+				{CounterKey{"a.Req", "page", "lt"}, 2},
+			},
+		},
+		{
+			// the rules failed as many times are sorted by the keys
+			n: 0,
+			want: []CounterEntry{
+				{CounterKey{"a.Req", "name", "min_size"}, 3},
+				{CounterKey{"a.Req", "page", "lt"}, 2},
+				{CounterKey{"a.Req", "page", "gt"}, 1},
+				{CounterKey{"b.Req", "", "assert"}, 1},
+			},
+		},
+		{
+			n: 10,
+			want: []CounterEntry{
+				{CounterKey{"a.Req", "name", "min_size"}, 3},
+				{CounterKey{"a.Req", "page", "lt"}, 2},
+				{CounterKey{"a.Req", "page", "gt"}, 1},
+				{CounterKey{"b.Req", "", "assert"}, 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		if got := c.Top(tt.n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Top(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+	c.Reset()
+	if got := c.Top(0); len(got) != 0 {
+		t.Errorf("Top() after Reset() = %v, want none", got)
+	}
+}
+
+type contextHook struct {
+	got []string
+}
+
+func (h *contextHook) Observe(message, field, rule string) {
+	h.got = append(h.got, "Observe "+rule)
+}
+
+func (h *contextHook) ObserveContext(ctx context.Context, message, field, rule string) {
+	h.got = append(h.got, fmt.Sprintf("ObserveContext %s %v", rule, ctx.Value(ctxKey{})))
+}
+
+func TestObserveContext(t *testing.T) {
+	defer SetHooks()
+	h := &contextHook{}
+	var got []string
+	SetHooks(h, HookFunc(func(message, field, rule string) {
+		got = append(got, rule)
+	}))
+	ObserveContext(context.WithValue(context.Background(), ctxKey{}, "ctx"), "fixture.Request", "page", "lt")
+	Observe("fixture.Request", "", "assert")
+	if want := []string{"ObserveContext lt ctx", "ObserveContext assert <nil>"}; !reflect.DeepEqual(h.got, want) {
+		t.Errorf("observed %q, want %q", h.got, want)
+	}
+	if want := []string{"lt", "assert"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("observed %q by the hook without context, want %q", got, want)
+	}
+}
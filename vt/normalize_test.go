@@ -0,0 +1,36 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import "testing"
+
+type normalizedMessage struct {
+	name string
+}
+
+func (m *normalizedMessage) Normalize() {
+	m.name = "normalized"
+}
+
+func TestNormalize(t *testing.T) {
+	m := &normalizedMessage{}
+	Normalize(m)
+	if m.name != "normalized" {
+		t.Errorf("Normalize() did not call Normalize of the message, name = %q", m.name)
+	}
+	// the messages without Normalize are left as is
+	Normalize(struct{}{})
+	Normalize(nil)
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"log"
+	"sync"
+)
+
+// Reporter receives the violations of the warn rules, which do not fail Validate.
+type Reporter func(v *Violation)
+
+var (
+	reporterMu sync.RWMutex
+	reporter   Reporter = func(v *Violation) {
+		log.Printf("vt: warn: %v", v)
+	}
+)
+
+// SetReporter sets the reporter of the violations of the warn rules, which are
+// logged by default, a nil reporter drops them.
+func SetReporter(r Reporter) {
+	reporterMu.Lock()
+	reporter = r
+	reporterMu.Unlock()
+}
+
+// Report passes the violation of a warn rule to the reporter.
+func Report(v *Violation) {
+	reporterMu.RLock()
+	r := reporter
+	reporterMu.RUnlock()
+	if r != nil {
+		r(v)
+	}
+}
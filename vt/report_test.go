@@ -0,0 +1,40 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReport(t *testing.T) {
+	defer SetReporter(reporter)
+	var got []string
+	SetReporter(func(v *Violation) {
+		got = append(got, v.ID)
+	})
+	Report(&Violation{ID: "vt.string.max_size"})
+	Report(&Violation{ID: "vt.int.gt"})
+	if want := []string{"vt.string.max_size", "vt.int.gt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("reported %q, want %q", got, want)
+	}
+
+	// a nil reporter drops the violations
+	SetReporter(nil)
+	Report(&Violation{ID: "vt.int.lt"})
+	if len(got) != 2 {
+		t.Errorf("reported %q after the reporter is removed", got)
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the locale of the messages returned by Error.
+var DefaultLocale = "en"
+
+// Translator translates a message id or a msg key into a template of the locale,
+// the placeholders {field}, {value} and {constraint} are replaced in the template.
+type Translator interface {
+	Translate(locale, key string) (string, bool)
+}
+
+var (
+	translatorMu sync.RWMutex
+	translator   Translator
+)
+
+// SetTranslator sets the translator of the messages, the English catalog is used
+// for the message ids not translated.
+func SetTranslator(t Translator) {
+	translatorMu.Lock()
+	translator = t
+	translatorMu.Unlock()
+}
+
+func translate(locale, key string) (string, bool) {
+	translatorMu.RLock()
+	t := translator
+	translatorMu.RUnlock()
+	if t != nil {
+		if tmpl, ok := t.Translate(locale, key); ok {
+			return tmpl, true
+		}
+	}
+	tmpl, ok := English[key]
+	return tmpl, ok
+}
+
+// Catalogs is a Translator of the catalogs of the locales, a catalog maps the
+// message ids and the msg keys to the templates.
+type Catalogs struct {
+	mu       sync.RWMutex
+	catalogs map[string]map[string]string
+}
+
+// NewCatalogs returns an empty Catalogs.
+func NewCatalogs() *Catalogs {
+	return &Catalogs{catalogs: map[string]map[string]string{}}
+}
+
+// Add adds the templates of catalog to the catalog of the locale.
+func (c *Catalogs) Add(locale string, catalog map[string]string) {
+	locale = normalizeLocale(locale)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	m, ok := c.catalogs[locale]
+	if !ok {
+		m = make(map[string]string, len(catalog))
+		c.catalogs[locale] = m
+	}
+	for k, v := range catalog {
+		m[k] = v
+	}
+}
+
+// LoadFile adds the catalog of the locale in a json file, which is an object
+// mapping the keys to the templates.
+func (c *Catalogs) LoadFile(locale, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	catalog := map[string]string{}
+	if err := json.Unmarshal(data, &catalog); err != nil {
+		return fmt.Errorf("load catalog %s failed: %v", path, err)
+	}
+	c.Add(locale, catalog)
+	return nil
+}
+
+// LoadDir adds the catalogs in the json files of dir, the locale of a catalog is
+// the name of its file, e.g. zh-CN.json.
+func (c *Catalogs) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := c.LoadFile(strings.TrimSuffix(filepath.Base(f), ".json"), f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Translate looks up the key in the catalog of the locale and then of its language,
+// e.g. zh-CN and then zh. The locale can be an Accept-Language header.
+func (c *Catalogs) Translate(locale, key string) (string, bool) {
+	locale = normalizeLocale(locale)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if tmpl, ok := c.catalogs[locale][key]; ok {
+		return tmpl, true
+	}
+	if i := strings.IndexByte(locale, '-'); i > 0 {
+		tmpl, ok := c.catalogs[locale[:i]][key]
+		return tmpl, ok
+	}
+	return "", false
+}
+
+// normalizeLocale returns the first locale of an Accept-Language header like
+// "zh-CN,zh;q=0.9" in lower case with '-' separated tags.
+func normalizeLocale(locale string) string {
+	if i := strings.IndexAny(locale, ",;"); i >= 0 {
+		locale = locale[:i]
+	}
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import "testing"
+
+func TestCatalogsTranslate(t *testing.T) {
+	c := NewCatalogs()
+	if err := c.LoadDir("testdata"); err != nil {
+		t.Fatal(err)
+	}
+	c.Add("zh-TW", map[string]string{"vt.int.gt": "{field} 必須大於 {constraint}"})
+	tests := []struct {
+		locale string
+		key    string
+		want   string
+		ok     bool
+	}{
+		{"zh", "vt.int.gt", "{field} 必须大于 {constraint}，当前值：{value}", true},
+		{"zh-CN", "vt.int.gt", "{field} 必须大于 {constraint}，当前值：{value}", true},
+		{"zh-CN,zh;q=0.9,en;q=0.8", "vt.int.gt", "{field} 必须大于 {constraint}，当前值：{value}", true},
+		{"zh_TW", "vt.int.gt", "{field} 必須大於 {constraint}", true},
+		{"fr-ca", "name.required", "le nom est obligatoire", true},
+		{"fr", "name.required", "", false},
+		{"zh", "vt.int.lt", "", false},
+		{"", "vt.int.gt", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale+"/"+tt.key, func(t *testing.T) {
+			got, ok := c.Translate(tt.locale, tt.key)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("Translate() = %q, %v, want %q, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestLoadFile(t *testing.T) {
+	c := NewCatalogs()
+	if err := c.LoadFile("en", "testdata/missing.json"); err == nil {
+		t.Error("LoadFile() of a missing file succeeded")
+	}
+	if err := c.LoadFile("en", "catalog.go"); err == nil {
+		t.Error("LoadFile() of a file that is not json succeeded")
+	}
+}
+
+func TestSetTranslator(t *testing.T) {
+	c := NewCatalogs()
+	c.Add("zh", map[string]string{"vt.int.gt": "{field} 必须大于 {constraint}", "name.required": "名称必填"})
+	SetTranslator(c)
+	defer SetTranslator(nil)
+
+	v := &Violation{ID: "vt.int.gt", Name: "count", Value: 0, Constraint: "0"}
+	tests := []struct {
+		locale string
+		v      *Violation
+		want   string
+	}{
+		{"zh-CN", v, "count 必须大于 0"},
+		// the English catalog is used for the ids not translated
+		{"fr", v, "field count gt rule failed, current value: 0"},
+		{"zh", &Violation{ID: "vt.int.lt", Name: "count", Value: 1}, "field count lt rule failed, current value: 1"},
+		{"zh", &Violation{ID: "vt.string.min_size", Name: "name", Message: "name.required"}, "名称必填"},
+		{"en", &Violation{ID: "vt.string.min_size", Name: "name", Message: "name.required"}, "name.required"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.Localize(tt.locale); got != tt.want {
+			t.Errorf("Localize(%q) = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
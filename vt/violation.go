@@ -0,0 +1,118 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vt is the runtime of the code generated by protoc-gen-validator, the
+// generated Validate methods return a *Violation for a failed rule.
+package vt
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Violation is the error of a failed rule.
+type Violation struct {
+	// ID is the stable message id of the rule, e.g. "vt.string.min_size".
+	ID string
+	// Field is the path of the field in idl joined by dots, e.g. "inner.code", which
+	// is the path of the message for the message level rules.
+	Field string
+	// Name is the name of the field in idl, or the name of the message for the
+	// message level rules.
+	Name string
+	// Value is the current value, nil for the rules without a value.
+	Value interface{}
+	// Constraint is the constraint of the rule as written in the annotation.
+	Constraint string
+	// Message is the msg of the rule, which is a key of the catalogs or the template
+	// itself. The message of ID is used if it's empty.
+	Message string
+
+	// nested are the names of the fields of the nested messages from the root.
+	nested []string
+}
+
+// Error returns the message in DefaultLocale, prefixed with the nested fields.
+func (v *Violation) Error() string {
+	var b strings.Builder
+	for _, name := range v.nested {
+		b.WriteString("filed ")
+		b.WriteString(name)
+		b.WriteString(" not valid, ")
+	}
+	b.WriteString(v.Localize(DefaultLocale))
+	return b.String()
+}
+
+// Localize returns the message of the violation in the locale, the placeholders
+// {field}, {value} and {constraint} are replaced.
+func (v *Violation) Localize(locale string) string {
+	tmpl, ok := "", false
+	if v.Message != "" {
+		if tmpl, ok = translate(locale, v.Message); !ok {
+			tmpl = v.Message
+		}
+	} else if tmpl, ok = translate(locale, v.ID); !ok {
+		tmpl = v.ID
+	}
+	value := ""
+	if v.Value != nil {
+		value = fmt.Sprint(v.Value)
+	}
+	return strings.NewReplacer("{field}", v.Name, "{value}", value, "{constraint}", v.Constraint).Replace(tmpl)
+}
+
+// Nested returns the error of the nested message in the field name, the path of a
+// violation is prefixed with the name.
+func Nested(name string, err error) error {
+	var v *Violation
+	if !errors.As(err, &v) {
+		return fmt.Errorf("filed %s not valid, %w", name, err)
+	}
+	nv := *v
+	nv.nested = append([]string{name}, v.nested...)
+	if v.Field == "" {
+		nv.Field = name
+	} else {
+		nv.Field = name + "." + v.Field
+	}
+	return &nv
+}
+
+// NestedIndex returns the error of the nested message at the index of the list
+// field name, e.g. items[0].
+func NestedIndex(name string, index int, err error) error {
+	return Nested(name+"["+strconv.Itoa(index)+"]", err)
+}
+
+// NestedKey returns the error of the nested message at the key of the map field
+// name, e.g. prices["k"], the string keys are quoted.
+func NestedKey(name string, key interface{}, err error) error {
+	if s, ok := key.(string); ok {
+		return Nested(name+"["+strconv.Quote(s)+"]", err)
+	}
+	return Nested(fmt.Sprintf("%s[%v]", name, key), err)
+}
+
+// Localize returns the message of err in the locale if it's a violation, or the
+// message of err otherwise.
+func Localize(err error, locale string) string {
+	var v *Violation
+	if errors.As(err, &v) {
+		return v.Localize(locale)
+	}
+	return err.Error()
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vt
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestViolationLocalize(t *testing.T) {
+	tests := []struct {
+		name string
+		v    *Violation
+		want string
+	}{
+		{
+			name: "id",
+			v:    &Violation{ID: "vt.int.gt", Name: "count", Value: int64(0), Constraint: "0"},
+			want: "field count gt rule failed, current value: 0",
+		},
+		{
+			name: "without value",
+			v:    &Violation{ID: "vt.message.not_nil", Name: "main"},
+			want: "field main not_nil rule failed",
+		},
+		{
+			name: "message template",
+			v:    &Violation{ID: "vt.int.gt", Name: "count", Value: 0, Constraint: "0", Message: "{field} must be greater than {constraint}, got {value}"},
+			want: "count must be greater than 0, got 0",
+		},
+		{
+			name: "message key",
+			v:    &Violation{ID: "vt.int.gt", Name: "count", Message: "vt.message.assert"},
+			want: "struct assertion failed",
+		},
+		{
+			name: "unknown id",
+			v:    &Violation{ID: "vt.unknown"},
+			want: "vt.unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Localize("en"); got != tt.want {
+				t.Errorf("Localize() = %q, want %q", got, tt.want)
+			}
+			if got := tt.v.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNested(t *testing.T) {
+	base := &Violation{ID: "vt.string.min_size", Field: "name", Name: "name", Value: 0}
+	tests := []struct {
+		name  string
+		err   error
+		field string
+		msg   string
+	}{
+		{
+			name:  "field",
+			err:   Nested("main", base),
+			field: "main.name",
+			msg:   "filed main not valid, field name min_len rule failed, current value: 0",
+		},
+		{
+			name:  "index",
+			err:   NestedIndex("items", 1, base),
+			field: "items[1].name",
+			msg:   "filed items[1] not valid, field name min_len rule failed, current value: 0",
+		},
+		{
+			name:  "string key",
+			err:   NestedKey("slots", "a\"b", base),
+			field: `slots["a\"b"].name`,
+			msg:   `filed slots["a\"b"] not valid, field name min_len rule failed, current value: 0`,
+		},
+		{
+			name:  "int key",
+			err:   NestedKey("slots", int32(7), base),
+			field: "slots[7].name",
+			msg:   "filed slots[7] not valid, field name min_len rule failed, current value: 0",
+		},
+		{
+			name:  "twice",
+			err:   Nested("outer", NestedIndex("items", 0, base)),
+			field: "outer.items[0].name",
+			msg:   "filed outer not valid, filed items[0] not valid, field name min_len rule failed, current value: 0",
+		},
+		{
+			name:  "message level",
+			err:   Nested("main", &Violation{ID: "vt.message.assert", Name: "Item"}),
+			field: "main",
+			msg:   "filed main not valid, struct assertion failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v *Violation
+			if !errors.As(tt.err, &v) {
+				t.Fatalf("%T is not a *Violation", tt.err)
+			}
+			if v.Field != tt.field {
+				t.Errorf("Field = %q, want %q", v.Field, tt.field)
+			}
+			if got := tt.err.Error(); got != tt.msg {
+				t.Errorf("Error() = %q, want %q", got, tt.msg)
+			}
+		})
+	}
+	if base.Field != "name" || len(base.nested) != 0 {
+		t.Errorf("the nested violation is modified: %+v", base)
+	}
+}
+
+func TestNestedError(t *testing.T) {
+	err := Nested("main", errors.New("custom"))
+	if got, want := err.Error(), "filed main not valid, custom"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if got := Localize(err, "en"); got != err.Error() {
+		t.Errorf("Localize() = %q, want %q", got, err.Error())
+	}
+	wrapped := fmt.Errorf("wrapped: %w", &Violation{ID: "vt.message.assert"})
+	if got, want := Localize(wrapped, "en"), "struct assertion failed"; got != want {
+		t.Errorf("Localize() = %q, want %q", got, want)
+	}
+}
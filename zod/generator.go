@@ -0,0 +1,379 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zod
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"github.com/cloudwego/protoc-gen-validator/validator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Generator writes a <name>.zod.ts with a zod schema for every message and enum
+// of a proto file. The messages follow the proto3 JSON mapping.
+type Generator struct {
+	*protogen.Plugin
+	PbFile *protogen.File
+
+	parser  *parser.Parser
+	body    bytes.Buffer
+	imports map[string]string // proto file path -> alias
+	helpers map[string]bool
+	skipped []string
+	// severity is the default severity of the rules
+	severity string
+
+	// lazy are the messages referenced before they are declared, which happens
+	// only for recursive messages.
+	lazy     map[*protogen.Message]bool
+	declared map[*protogen.Message]bool
+}
+
+func NewGenerator(plu *protogen.Plugin, file *protogen.File) *Generator {
+	return &Generator{
+		Plugin:   plu,
+		PbFile:   file,
+		parser:   parser.NewParser(),
+		imports:  make(map[string]string),
+		helpers:  make(map[string]bool),
+		lazy:     make(map[*protogen.Message]bool),
+		declared: make(map[*protogen.Message]bool),
+	}
+}
+
+func (g *Generator) Generate() error {
+	for _, enum := range allEnums(g.PbFile.Enums, g.PbFile.Messages) {
+		g.generateEnum(enum)
+	}
+	msgs := g.sortMessages()
+	for _, msg := range msgs {
+		if err := g.generateMessage(msg); err != nil {
+			return fmt.Errorf("generate zod schema for %s failed: %w", msg.Desc.FullName(), err)
+		}
+	}
+
+	genFile := g.NewGeneratedFile(g.PbFile.GeneratedFilenamePrefix+".zod.ts", "")
+	genFile.P("// Code generated by protoc-gen-validator ", validator.Version, ". DO NOT EDIT.")
+	genFile.P("// source: ", g.PbFile.Desc.Path())
+	if len(g.skipped) > 0 {
+		genFile.P("//")
+		genFile.P("// The following rules are only checked by the server:")
+		for _, s := range g.skipped {
+			genFile.P("//   ", s)
+		}
+	}
+	genFile.P()
+	genFile.P(`import { z } from "zod";`)
+	var files []string
+	for file := range g.imports {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		genFile.P(`import * as `, g.imports[file], ` from "`, g.importPath(file), `";`)
+	}
+	genFile.P()
+	for _, name := range []string{helperByteLength, helperSprintf} {
+		if g.helpers[name] {
+			genFile.P(helpers[name])
+		}
+	}
+	genFile.Write(g.body.Bytes())
+	return nil
+}
+
+func (g *Generator) p(format string, a ...interface{}) {
+	fmt.Fprintf(&g.body, format, a...)
+	g.body.WriteByte('\n')
+}
+
+// skip reports a rule which can not be translated, the server still checks it.
+func (g *Generator) skip(msg *protogen.Message, field *protogen.Field, rule *parser.Rule, reason string) {
+	name := string(msg.Desc.FullName())
+	if field != nil {
+		name += "." + string(field.Desc.Name())
+	}
+	s := fmt.Sprintf("%s: %s skipped, %s", name, parser.KeyString[rule.Key], reason)
+	log.Printf("zod: %s\n", s)
+	g.skipped = append(g.skipped, s)
+}
+
+func allEnums(enums []*protogen.Enum, msgs []*protogen.Message) []*protogen.Enum {
+	ret := append([]*protogen.Enum{}, enums...)
+	for _, msg := range msgs {
+		ret = append(ret, allEnums(msg.Enums, msg.Messages)...)
+	}
+	return ret
+}
+
+func (g *Generator) generateEnum(enum *protogen.Enum) {
+	var names []string
+	for _, v := range enum.Values {
+		names = append(names, quote(string(v.Desc.Name())))
+	}
+	name := enum.GoIdent.GoName
+	g.p("export const %sSchema = z.enum([%s]);", name, strings.Join(names, ", "))
+	g.p("export type %s = z.infer<typeof %sSchema>;", name, name)
+	g.p("")
+}
+
+// sortMessages orders the messages of the file so that the referenced ones are
+// declared first, the references closing a cycle are marked lazy.
+func (g *Generator) sortMessages() []*protogen.Message {
+	var (
+		ret      []*protogen.Message
+		visiting = make(map[*protogen.Message]bool)
+		done     = make(map[*protogen.Message]bool)
+		visit    func(msg *protogen.Message)
+	)
+	visit = func(msg *protogen.Message) {
+		if done[msg] {
+			return
+		}
+		visiting[msg] = true
+		for _, f := range msg.Fields {
+			dep := f.Message
+			if dep != nil && dep.Desc.IsMapEntry() {
+				dep = dep.Fields[1].Message
+			}
+			if dep == nil || dep.Desc.ParentFile() != g.PbFile.Desc {
+				continue
+			}
+			if visiting[dep] {
+				g.lazy[dep] = true
+				continue
+			}
+			visit(dep)
+		}
+		visiting[msg] = false
+		done[msg] = true
+		ret = append(ret, msg)
+	}
+	var walk func(msgs []*protogen.Message)
+	walk = func(msgs []*protogen.Message) {
+		for _, msg := range msgs {
+			if !msg.Desc.IsMapEntry() {
+				visit(msg)
+			}
+			walk(msg.Messages)
+		}
+	}
+	walk(g.PbFile.Messages)
+	return ret
+}
+
+// SetSeverity sets the severity of the rules without one, error or warn, like the
+// severity parameter of the plugin. The warn rules are left out of the schemas.
+func (g *Generator) SetSeverity(severity string) {
+	g.severity = severity
+}
+
+func (g *Generator) generateMessage(msg *protogen.Message) error {
+	msgValidation, fieldValidations, err := g.parser.Parse(msg)
+	if err != nil {
+		return err
+	}
+	name := msg.GoIdent.GoName
+	var checks []*check
+	if g.lazy[msg] {
+		// recursive schemas can not be inferred by typescript
+		g.p("export const %sSchema: z.ZodTypeAny = z.object({", name)
+	} else {
+		g.p("export const %sSchema = z.object({", name)
+	}
+	for _, f := range msg.Fields {
+		v := enforced(fieldValidations[f.Desc.Number()], g.severity)
+		if v != nil && len(v.Rules) == 0 {
+			v = nil
+		}
+		fieldSchema := g.fieldSchema
+		if v != nil && v.When != nil {
+			fieldSchema = g.whenFieldSchema
+		}
+		schema, fieldChecks, err := fieldSchema(msg, f, v)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", f.Desc.Name(), err)
+		}
+		g.p("  %s: %s,", propertyName(f), schema)
+		checks = append(checks, fieldChecks...)
+	}
+	for _, rule := range msgValidation.Rules {
+		if rule.IsWarn(g.severity) {
+			continue
+		}
+		if rule.Key == parser.OneofRequired {
+			checks = append(checks, oneofCheck(msg, rule.Specified.TypedValue.Binary))
+			continue
+		}
+		if rule.Key != parser.Assert {
+			return fmt.Errorf("unknown struct like annotation %s", parser.KeyString[rule.Key])
+		}
+		r := &renderer{gen: g, owner: "v", allowOwner: true}
+		cond, err := r.value(nil, rule.Specified)
+		if err != nil {
+			g.skip(msg, nil, rule, err.Error())
+			continue
+		}
+		checks = append(checks, &check{cond: cond, message: "struct assertion failed"})
+	}
+	if len(checks) == 0 {
+		g.p("});")
+	} else {
+		g.p("}).superRefine((v, ctx) => {")
+		for _, c := range checks {
+			cond := "!(" + c.cond + ")"
+			if c.guard != "" {
+				cond = c.guard + " && " + cond
+			}
+			g.p("  if (%s) {", cond)
+			g.p("    ctx.addIssue({ code: z.ZodIssueCode.custom, path: [%s], message: %s });", c.path, quote(c.message))
+			g.p("  }")
+		}
+		g.p("});")
+	}
+	if !g.lazy[msg] {
+		g.p("export type %s = z.infer<typeof %sSchema>;", name, name)
+	}
+	g.p("")
+	g.declared[msg] = true
+	return nil
+}
+
+// oneofCheck requires one of the fields of the oneof to be set, which are all
+// optional properties.
+func oneofCheck(msg *protogen.Message, name string) *check {
+	var values []string
+	for _, oneof := range msg.Oneofs {
+		if string(oneof.Desc.Name()) != name {
+			continue
+		}
+		for _, f := range oneof.Fields {
+			values = append(values, accessor("v", f))
+		}
+	}
+	return &check{
+		path:    quote(name),
+		cond:    "[" + strings.Join(values, ", ") + "].some((x) => x !== undefined)",
+		message: "oneof " + name + " required rule failed",
+	}
+}
+
+// messageSchema refers to the schema of msg, which may be declared in another file.
+func (g *Generator) messageSchema(msg *protogen.Message) string {
+	if s, ok := wellKnownSchema(msg.Desc.FullName()); ok {
+		return s
+	}
+	name := g.qualify(msg.Desc.ParentFile(), msg.GoIdent.GoName) + "Schema"
+	if msg.Desc.ParentFile() == g.PbFile.Desc && !g.declared[msg] {
+		return "z.lazy(() => " + name + ")"
+	}
+	return name
+}
+
+func (g *Generator) enumSchema(enum *protogen.Enum) string {
+	return g.qualify(enum.Desc.ParentFile(), enum.GoIdent.GoName) + "Schema"
+}
+
+func (g *Generator) qualify(file protoreflect.FileDescriptor, name string) string {
+	if file == g.PbFile.Desc {
+		return name
+	}
+	alias, ok := g.imports[file.Path()]
+	if !ok {
+		base := strings.TrimSuffix(path.Base(file.Path()), ".proto")
+		alias = fmt.Sprintf("%s%d", identifier(base), len(g.imports))
+		g.imports[file.Path()] = alias
+	}
+	return alias + "." + name
+}
+
+// importPath returns the relative path of the zod file generated for the proto file.
+func (g *Generator) importPath(file string) string {
+	target := strings.TrimSuffix(file, ".proto") + ".zod"
+	for _, f := range g.Files {
+		if f.Desc.Path() == file {
+			target = f.GeneratedFilenamePrefix + ".zod"
+		}
+	}
+	from := strings.Split(path.Dir(g.PbFile.GeneratedFilenamePrefix), "/")
+	to := strings.Split(target, "/")
+	if from[0] == "." {
+		from = nil
+	}
+	i := 0
+	for i < len(from) && i < len(to)-1 && from[i] == to[i] {
+		i++
+	}
+	rel := strings.Repeat("../", len(from)-i) + strings.Join(to[i:], "/")
+	if !strings.HasPrefix(rel, "../") {
+		rel = "./" + rel
+	}
+	return rel
+}
+
+func identifier(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r == '_' || r == '$' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
+
+// propertyName is the proto3 JSON name of f, quoted when it isn't an identifier.
+func propertyName(f *protogen.Field) string {
+	name := f.Desc.JSONName()
+	if identifier(name) != name || name[0] >= '0' && name[0] <= '9' {
+		return quote(name)
+	}
+	return name
+}
+
+// wellKnownSchema maps the well-known types to their JSON representations.
+func wellKnownSchema(name protoreflect.FullName) (string, bool) {
+	switch name {
+	case "google.protobuf.Timestamp", "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return "z.string()", true
+	case "google.protobuf.Struct", "google.protobuf.Any":
+		return "z.record(z.string(), z.unknown())", true
+	case "google.protobuf.Empty":
+		return "z.object({})", true
+	case "google.protobuf.ListValue":
+		return "z.array(z.unknown())", true
+	case "google.protobuf.Value":
+		return "z.unknown()", true
+	case "google.protobuf.BoolValue":
+		return "z.boolean()", true
+	case "google.protobuf.Int32Value", "google.protobuf.UInt32Value":
+		return "z.number().int()", true
+	case "google.protobuf.Int64Value", "google.protobuf.UInt64Value":
+		return "z.coerce.number().int()", true
+	case "google.protobuf.FloatValue", "google.protobuf.DoubleValue":
+		return "z.number()", true
+	case "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return "z.string()", true
+	}
+	return "", false
+}
@@ -0,0 +1,554 @@
+// Copyright 2022 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zod
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/protoc-gen-validator/parser"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	helperByteLength = "byteLength"
+	helperSprintf    = "sprintf"
+)
+
+// helpers are emitted once per file when used. Strings are measured in utf-8
+// bytes as the go len does.
+var helpers = map[string]string{
+	helperByteLength: `const byteLength = (s: string): number => new TextEncoder().encode(s).length;
+`,
+	helperSprintf: `const sprintf = (format: string, ...args: unknown[]): string => {
+  let i = 0;
+  return format.replace(/%[%vsdfqt]/g, (m) => (m === "%%" ? "%" : String(args[i++])));
+};
+`,
+}
+
+// check is a rule evaluated on the whole message, for the rules referring to
+// other fields.
+type check struct {
+	path    string
+	guard   string
+	cond    string
+	message string
+}
+
+// fieldSchema returns the schema of f with the rules of v, the rules which need
+// the sibling fields are returned as checks of the message.
+func (g *Generator) fieldSchema(msg *protogen.Message, f *protogen.Field, v *parser.Validation) (string, []*check, error) {
+	var rules []*parser.Rule
+	if v != nil {
+		rules = v.Rules
+	}
+	var (
+		schema string
+		checks []*check
+		notNil bool
+		owner  = accessor("v", f)
+	)
+	switch {
+	case f.Desc.IsMap():
+		keyField, valField := f.Message.Fields[0], f.Message.Fields[1]
+		key, val := "z.string()", g.typeSchema(valField, nil)
+		var refines []string
+		for _, rule := range rules {
+			switch rule.Key {
+			case parser.MinSize, parser.MaxSize:
+				refines, checks = g.applyRule(msg, f, f, rule, true, 0, refines, checks, owner)
+			case parser.NoSparse:
+				// the values of a valid payload are never null
+			case parser.MapKey:
+				if keyField.Desc.Kind() != protoreflect.StringKind {
+					g.skip(msg, f, rule, "the keys are strings in JSON")
+					continue
+				}
+				key, _ = g.scalarSchema(msg, f, keyField, rule.Inner, "")
+			case parser.MapValue:
+				val, _ = g.scalarSchema(msg, f, valField, rule.Inner, "")
+			default:
+				return "", nil, fmt.Errorf("unknown map annotation %s", parser.KeyString[rule.Key])
+			}
+		}
+		schema = fmt.Sprintf("z.record(%s, %s)", key, val) + strings.Join(refines, "") + ".default({})"
+	case f.Desc.IsList():
+		elem := g.typeSchema(f, nil)
+		var refines []string
+		for _, rule := range rules {
+			switch rule.Key {
+			case parser.MinSize, parser.MaxSize:
+				refines, checks = g.applyRule(msg, f, f, rule, true, 0, refines, checks, owner)
+			case parser.Elem:
+				elem, _ = g.scalarSchema(msg, f, f, rule.Inner, "")
+			default:
+				return "", nil, fmt.Errorf("unknown list annotation %s", parser.KeyString[rule.Key])
+			}
+		}
+		schema = fmt.Sprintf("z.array(%s)", elem) + strings.Join(refines, "") + ".default([])"
+	default:
+		schema, checks = g.scalarSchema(msg, f, f, v, owner)
+		for _, rule := range rules {
+			if rule.Key == parser.NotNil {
+				notNil = rule.Specified.TypedValue.Bool
+			}
+		}
+		switch {
+		case notNil:
+		case f.Desc.HasPresence():
+			schema += ".optional()"
+			for _, c := range checks {
+				c.guard = owner + " !== undefined"
+			}
+		default:
+			schema += ".default(" + zeroValue(f) + ")"
+		}
+	}
+	for _, c := range checks {
+		c.path = quote(f.Desc.JSONName())
+	}
+	return schema, checks, nil
+}
+
+// enforced returns the rules of v without the warn ones, which are only reported by
+// Validate, so the schemas don't reject the values it accepts.
+func enforced(v *parser.Validation, severity string) *parser.Validation {
+	if v == nil {
+		return nil
+	}
+	ret := *v
+	ret.Rules = nil
+	for _, rule := range v.Rules {
+		if rule.Inner != nil {
+			r := *rule
+			r.Inner = enforced(rule.Inner, severity)
+			ret.Rules = append(ret.Rules, &r)
+			continue
+		}
+		if !rule.IsWarn(severity) {
+			ret.Rules = append(ret.Rules, rule)
+		}
+	}
+	return &ret
+}
+
+// whenFieldSchema returns the schema of f whose rules are checked only if the when
+// condition holds, the rules are applied by a check of the message.
+func (g *Generator) whenFieldSchema(msg *protogen.Message, f *protogen.Field, v *parser.Validation) (string, []*check, error) {
+	schema, _, err := g.fieldSchema(msg, f, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	r := &renderer{gen: g, owner: "v", allowOwner: true}
+	when, err := r.function(v.When)
+	if err != nil {
+		for _, rule := range v.Rules {
+			g.skip(msg, f, rule, "the when condition "+err.Error())
+		}
+		return schema, nil, nil
+	}
+	ruled, checks, err := g.fieldSchema(msg, f, v)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, c := range checks {
+		if c.guard == "" {
+			c.guard = when
+		} else {
+			c.guard = when + " && " + c.guard
+		}
+	}
+	checks = append(checks, &check{
+		path:    quote(f.Desc.JSONName()),
+		guard:   when,
+		cond:    ruled + ".safeParse(" + accessor("v", f) + ").success",
+		message: "rules failed",
+	})
+	return schema, checks, nil
+}
+
+// scalarSchema applies the rules of a singular value, the elements of lists and
+// the keys and values of maps have no owner to refer to.
+func (g *Generator) scalarSchema(msg *protogen.Message, field, f *protogen.Field, v *parser.Validation, owner string) (string, []*check) {
+	var (
+		rules   []*parser.Rule
+		epsilon float64
+		refines []string
+		checks  []*check
+	)
+	if v != nil {
+		rules, epsilon = v.Rules, v.Epsilon
+	}
+	for _, rule := range rules {
+		switch rule.Key {
+		case parser.NotNil, parser.DefinedOnly:
+			// presence is handled by the caller, defined_only by the type schema
+		case parser.Skip:
+			if rule.Specified.TypedValue.Bool {
+				return "z.unknown()", nil
+			}
+		case parser.Finite:
+			if rule.Specified.TypedValue.Bool {
+				refines, checks = g.applyRule(msg, field, f, rule, false, epsilon, refines, checks, owner)
+			}
+		default:
+			refines, checks = g.applyRule(msg, field, f, rule, false, epsilon, refines, checks, owner)
+		}
+	}
+	return g.typeSchema(f, rules) + strings.Join(refines, ""), checks
+}
+
+// applyRule translates a rule to a refinement of the value when it only depends
+// on constants, otherwise to a check of the message.
+// The size rules of a container are applied to the list or map itself, a
+// positive epsilon turns the const of floats to a tolerance.
+func (g *Generator) applyRule(msg *protogen.Message, field, f *protogen.Field, rule *parser.Rule, container bool, epsilon float64, refines []string, checks []*check, owner string) ([]string, []*check) {
+	key := parser.KeyString[rule.Key]
+	r := &renderer{gen: g, owner: "v", allowOwner: owner != ""}
+	var vals []string
+	for _, val := range append(rule.Range, rule.Specified) {
+		if val == nil {
+			continue
+		}
+		s, err := r.value(f, val)
+		if err != nil {
+			g.skip(msg, field, rule, err.Error())
+			return refines, checks
+		}
+		vals = append(vals, s)
+	}
+	if r.usesOwner {
+		cond, err := g.condition(f, container, rule.Key, epsilon, owner, vals)
+		if err != nil {
+			g.skip(msg, field, rule, err.Error())
+			return refines, checks
+		}
+		return refines, append(checks, &check{cond: cond, message: key + " rule failed"})
+	}
+	cond, err := g.condition(f, container, rule.Key, epsilon, "x", vals)
+	if err != nil {
+		g.skip(msg, field, rule, err.Error())
+		return refines, checks
+	}
+	return append(refines, fmt.Sprintf(".refine((x) => %s, { message: %s })", cond, quote(key+" rule failed"))), checks
+}
+
+// condition is the javascript expression holding when the rule passes.
+func (g *Generator) condition(f *protogen.Field, container bool, key parser.Key, epsilon float64, x string, vals []string) (string, error) {
+	subject := x
+	isBytes := f.Desc.Kind() == protoreflect.BytesKind
+	if isBytes && !container {
+		// bytes are base64 encoded in JSON
+		subject = "atob(" + x + ")"
+	}
+	switch key {
+	case parser.Const:
+		if epsilon > 0 {
+			return "Math.abs(" + subject + " - " + vals[0] + ") <= " + strconv.FormatFloat(epsilon, 'g', -1, 64), nil
+		}
+		return subject + " === " + vals[0], nil
+	case parser.MultipleOf:
+		return subject + " % " + vals[0] + " === 0", nil
+	case parser.Finite:
+		return "Number.isFinite(" + subject + ")", nil
+	case parser.LessThan:
+		return subject + " < " + vals[0], nil
+	case parser.LessEqual:
+		return subject + " <= " + vals[0], nil
+	case parser.GreatThan:
+		return subject + " > " + vals[0], nil
+	case parser.GreatEqual:
+		return subject + " >= " + vals[0], nil
+	case parser.In:
+		return "[" + strings.Join(vals, ", ") + "].includes(" + subject + ")", nil
+	case parser.NotIn:
+		return "![" + strings.Join(vals, ", ") + "].includes(" + subject + ")", nil
+	case parser.MinSize, parser.MaxSize:
+		var size string
+		switch {
+		case container && f.Desc.IsMap():
+			size = "Object.keys(" + x + ").length"
+		case container:
+			size = x + ".length"
+		case isBytes:
+			size = subject + ".length"
+		default:
+			g.helpers[helperByteLength] = true
+			size = "byteLength(" + x + ")"
+		}
+		if key == parser.MinSize {
+			return size + " >= " + vals[0], nil
+		}
+		return size + " <= " + vals[0], nil
+	case parser.Pattern:
+		return "new RegExp(" + vals[0] + ").test(" + subject + ")", nil
+	case parser.Prefix:
+		return subject + ".startsWith(" + vals[0] + ")", nil
+	case parser.Suffix:
+		return subject + ".endsWith(" + vals[0] + ")", nil
+	case parser.Contains:
+		return subject + ".includes(" + vals[0] + ")", nil
+	case parser.NotContains:
+		return "!" + subject + ".includes(" + vals[0] + ")", nil
+	default:
+		return "", fmt.Errorf("unknown annotation %s", parser.KeyString[key])
+	}
+}
+
+// typeSchema is the schema of a singular value of f.
+func (g *Generator) typeSchema(f *protogen.Field, rules []*parser.Rule) string {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "z.boolean()"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "z.number().int()"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers may be encoded as strings
+		return "z.coerce.number().int()"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "z.number()"
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return "z.string()"
+	case protoreflect.EnumKind:
+		enum := g.enumSchema(f.Enum)
+		for _, rule := range rules {
+			if rule.Key == parser.DefinedOnly && rule.Specified.TypedValue.Bool {
+				return enum
+			}
+		}
+		return fmt.Sprintf("z.union([%s, z.number().int()])", enum)
+	default:
+		return g.messageSchema(f.Message)
+	}
+}
+
+func zeroValue(f *protogen.Field) string {
+	switch f.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return "false"
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return `""`
+	case protoreflect.EnumKind:
+		return quote(string(f.Desc.Enum().Values().Get(0).Name()))
+	default:
+		return "0"
+	}
+}
+
+// renderer renders the values of the rules as javascript expressions.
+type renderer struct {
+	gen *Generator
+	// owner is the parsed message, the field references are its properties
+	owner      string
+	allowOwner bool
+	usesOwner  bool
+}
+
+func (r *renderer) value(f *protogen.Field, v *parser.ValidationValue) (string, error) {
+	switch v.ValueType {
+	case parser.IntValue:
+		return strconv.FormatInt(v.TypedValue.Int, 10), nil
+	case parser.DoubleValue:
+		return strconv.FormatFloat(v.TypedValue.Double, 'g', -1, 64), nil
+	case parser.BoolValue:
+		return strconv.FormatBool(v.TypedValue.Bool), nil
+	case parser.BinaryValue:
+		if f != nil && f.Desc.Kind() == protoreflect.EnumKind {
+			// enum constants are written as Type.VALUE, JSON uses the value names
+			divId := strings.Split(v.TypedValue.Binary, ".")
+			return quote(divId[len(divId)-1]), nil
+		}
+		return quote(v.TypedValue.Binary), nil
+	case parser.EnumValue:
+		return quote(v.TypedValue.Binary), nil
+	case parser.FieldReferenceValue:
+		if !r.allowOwner {
+			return "", errors.New("field references are not available for elements, keys and values")
+		}
+		r.usesOwner = true
+		return accessor(r.owner, v.TypedValue.FieldReference), nil
+	case parser.FunctionValue:
+		return r.function(v.TypedValue.Function)
+	default:
+		return "", fmt.Errorf("value type %s is not supported", v.ValueType)
+	}
+}
+
+func (r *renderer) function(f *parser.ToolFunction) (string, error) {
+	switch f.Name {
+	case "len":
+		if len(f.Arguments) != 1 || f.Arguments[0].ValueType != parser.FieldReferenceValue {
+			return "", errors.New("function len needs a field reference argument")
+		}
+		ref := f.Arguments[0].TypedValue.FieldReference
+		x, err := r.value(nil, &f.Arguments[0])
+		if err != nil {
+			return "", err
+		}
+		switch {
+		case ref.Desc.IsMap():
+			return "Object.keys(" + x + ").length", nil
+		case ref.Desc.IsList():
+			return x + ".length", nil
+		case ref.Desc.Kind() == protoreflect.BytesKind:
+			return "atob(" + x + ").length", nil
+		default:
+			r.gen.helpers[helperByteLength] = true
+			return "byteLength(" + x + ")", nil
+		}
+	case "sprintf":
+		args, err := r.arguments(f)
+		if err != nil {
+			return "", err
+		}
+		r.gen.helpers[helperSprintf] = true
+		return "sprintf(" + strings.Join(args, ", ") + ")", nil
+	case "equal", "mod", "add":
+		args, err := r.arguments(f)
+		if err != nil {
+			return "", err
+		}
+		if len(args) < 2 {
+			return "", fmt.Errorf("binary function %s needs at least 2 arguments", f.Name)
+		}
+		op := map[string]string{"equal": "===", "mod": "%", "add": "+"}[f.Name]
+		return "(" + args[0] + " " + op + " " + args[1] + ")", nil
+	case "size", "has":
+		if len(f.Arguments) != 1 || f.Arguments[0].ValueType != parser.FieldReferenceValue {
+			return "", fmt.Errorf("function %s needs a field reference argument", f.Name)
+		}
+		ref := f.Arguments[0].TypedValue.FieldReference
+		x, err := r.value(nil, &f.Arguments[0])
+		if err != nil {
+			return "", err
+		}
+		if f.Name == "has" {
+			return present(ref, x), nil
+		}
+		switch {
+		case ref.Desc.IsMap():
+			return "Object.keys(" + x + ").length", nil
+		case ref.Desc.IsList():
+			return x + ".length", nil
+		case ref.Desc.Kind() == protoreflect.BytesKind:
+			return "atob(" + x + ").length", nil
+		default:
+			// code points
+			return "[..." + x + "].length", nil
+		}
+	case "and", "or", "not":
+		args, err := r.arguments(f)
+		if err != nil {
+			return "", err
+		}
+		if len(args) == 0 {
+			return "", fmt.Errorf("function %s needs at least 1 argument", f.Name)
+		}
+		switch f.Name {
+		case "and":
+			return "(" + strings.Join(args, " && ") + ")", nil
+		case "or":
+			return "(" + strings.Join(args, " || ") + ")", nil
+		default:
+			return "!" + args[0], nil
+		}
+	case "ne", "lt", "le", "gt", "ge":
+		for i, arg := range f.Arguments {
+			if f.Name == "ne" && len(f.Arguments) == 2 && f.Arguments[1-i].ValueType == parser.EnumValue {
+				// the names of the enum values are compared
+				continue
+			}
+			if arg.ValueType == parser.FieldReferenceValue && arg.TypedValue.FieldReference.Desc.Kind() == protoreflect.EnumKind {
+				return "", errors.New("enum values are names in JSON and can not be compared with numbers")
+			}
+		}
+		args, err := r.arguments(f)
+		if err != nil {
+			return "", err
+		}
+		if len(args) < 2 {
+			return "", fmt.Errorf("binary function %s needs at least 2 arguments", f.Name)
+		}
+		op := map[string]string{"ne": "!==", "lt": "<", "le": "<=", "gt": ">", "ge": ">="}[f.Name]
+		return "(" + args[0] + " " + op + " " + args[1] + ")", nil
+	case "now_unix_nano":
+		return "", errors.New("now_unix_nano depends on the server clock")
+	default:
+		return "", fmt.Errorf("customized function %s is only available on the server", f.Name)
+	}
+}
+
+func (r *renderer) arguments(f *parser.ToolFunction) ([]string, error) {
+	var args []string
+	for i := range f.Arguments {
+		arg, err := r.value(nil, &f.Arguments[i])
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+// present is the has() of CEL on a parsed value x of f, the fields without
+// presence are set when they are not the zero value.
+func present(f *protogen.Field, x string) string {
+	switch {
+	case f.Desc.IsMap():
+		return "(Object.keys(" + x + ").length > 0)"
+	case f.Desc.IsList():
+		return "(" + x + ".length > 0)"
+	case f.Desc.HasPresence():
+		return "(" + x + " !== undefined)"
+	}
+	switch f.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return "(" + x + ` !== "")`
+	case protoreflect.BoolKind:
+		return "(" + x + " === true)"
+	case protoreflect.EnumKind:
+		if zero := f.Enum.Desc.Values().ByNumber(0); zero != nil {
+			return "(" + x + " !== 0 && " + x + " !== " + quote(string(zero.Name())) + ")"
+		}
+		return "(" + x + " !== 0)"
+	default:
+		return "(" + x + " !== 0)"
+	}
+}
+
+func accessor(owner string, f *protogen.Field) string {
+	name := f.Desc.JSONName()
+	if identifier(name) != name || name[0] >= '0' && name[0] <= '9' {
+		return owner + "[" + quote(name) + "]"
+	}
+	return owner + "." + name
+}
+
+// quote returns a javascript string literal.
+func quote(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimRight(buf.String(), "\n")
+}